@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"time"
 
 	flags "github.com/jessevdk/go-flags"
 )
@@ -13,34 +14,63 @@ var AppConfigFilePath = filepath.Join(defaultAppDataDir, "godcr.conf")
 
 // ConfFileOptions holds the top-level options/flags that should be set in config file rather than in command-line
 type ConfFileOptions struct {
-	AppDataDir       string `long:"appdata" description:"Path to application data directory."`
-	DefaultWalletDir string `long:"wallet" description:"Directory of wallet to connect to by default."`
-	WalletRPCServer  string `long:"walletrpcserver" description:"RPC server address of running dcrwallet daemon. Required to connect to wallet via dcrwallet."`
-	WalletRPCCert    string `long:"walletrpccert" description:"Path to dcrwallet certificate file. Required if walletrpcserver is set."`
-	NoWalletRPCTLS   bool   `long:"nowalletrpctls" description:"Disable TLS when connecting to dcrwallet daemon via RPC."`
-	HTTPHost         string `long:"httphost" description:"HTTP server host address or IP when running godcr in http mode."`
-	HTTPPort         string `long:"httpport" description:"HTTP server port when running godcr in http mode."`
-	DebugLevel       string `long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical}"`
+	AppDataDir          string        `long:"appdata" description:"Path to application data directory."`
+	DefaultWalletDir    string        `long:"wallet" description:"Directory of wallet to connect to by default."`
+	WalletRPCServer     string        `long:"walletrpcserver" description:"RPC server address of running dcrwallet daemon. Required to connect to wallet via dcrwallet."`
+	WalletRPCCert       string        `long:"walletrpccert" description:"Path to dcrwallet certificate file. Required if walletrpcserver is set."`
+	NoWalletRPCTLS      bool          `long:"nowalletrpctls" description:"Disable TLS when connecting to dcrwallet daemon via RPC."`
+	WalletRPCClientCert string        `long:"walletrpcclientcert" description:"Path to the client certificate presented to dcrwallet. Required if dcrwallet authenticates clients with clientcafile."`
+	WalletRPCClientKey  string        `long:"walletrpcclientkey" description:"Path to the key of the client certificate presented to dcrwallet."`
+	SyncMode            string        `long:"syncmode" description:"How the wallet syncs with the decred network. spv syncs with peers on the network, rpc syncs through a trusted dcrd node set with dcrdrpcserver." choice:"spv" choice:"rpc"`
+	DcrdRPCServer       string        `long:"dcrdrpcserver" description:"RPC server address of the dcrd node to sync through. Required if syncmode is rpc."`
+	DcrdRPCUser         string        `long:"dcrdrpcuser" description:"Username for RPC connections to dcrd."`
+	DcrdRPCPassword     string        `long:"dcrdrpcpass" default-mask:"-" description:"Password for RPC connections to dcrd."`
+	DcrdRPCCert         string        `long:"dcrdrpccert" description:"Path to dcrd certificate file."`
+	HTTPHost            string        `long:"httphost" description:"HTTP server host address or IP when running godcr in http mode."`
+	HTTPPort            string        `long:"httpport" description:"HTTP server port when running godcr in http mode."`
+	HTTPTLS             bool          `long:"httptls" description:"Serve the web interface over https. A self-signed certificate is generated if httpcert and httpkey do not exist."`
+	HTTPCert            string        `long:"httpcert" description:"Path to the tls certificate file used when httptls is set. Defaults to http.cert in the app data directory."`
+	HTTPKey             string        `long:"httpkey" description:"Path to the tls key file used when httptls is set. Defaults to http.key in the app data directory."`
+	HTTPAssetsDir       string        `long:"httpassetsdir" description:"Load web templates and static files from this directory (e.g. path/to/godcr/web) instead of the copies built into godcr. Useful when developing the web interface."`
+	HTTPUsername        string        `long:"httpusername" description:"Username required to log in to the web interface. Set with godcr httpauth."`
+	HTTPPasswordHash    string        `long:"httppasswordhash" description:"Bcrypt hash of the password required to log in to the web interface. Set with godcr httpauth."`
+	HTTPAPITokens       []string      `long:"httpapitoken" description:"Token that api clients can send in an 'Authorization: Bearer <token>' header. Can be set multiple times."`
+	HTTPSessionTimeout  time.Duration `long:"httpsessiontimeout" description:"Duration after which a web interface login session expires."`
+	DebugLevel          string        `long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical}"`
+	LogFormat           string        `long:"logformat" description:"Format of log file entries. json writes each entry as a json object with timestamp, level, subsystem, message and fields." choice:"text" choice:"json"`
 
 	Settings `group:"Settings"`
 }
 
 type Settings struct {
-	SpendUnconfirmed                    bool     `long:"spendunconfirmed" description:"Spend unconfirmed funds"`
-	ShowIncomingTransactionNotification bool     `long:"incomingtxnotification" description:"Show incoming transaction notification"`
-	ShowNewBlockNotification            bool     `long:"newblocknotification" description:"Show new block notification"`
-	CurrencyConverter                   string   `long:"currencyconverter" description:"Currency Converter {none, bitrex}" choice:"none" choice:"bitrex" default:"none"`
-	HiddenAccounts                      []uint32 `long:"hiddenaccounts" description:"Accounts with ignored balances"`
-	DefaultAccount                      uint32   `long:"defaultaccount" description:"Default account for incoming and outgoing transactions"`
+	SpendUnconfirmed                    bool              `long:"spendunconfirmed" description:"Spend unconfirmed funds"`
+	ShowIncomingTransactionNotification bool              `long:"incomingtxnotification" description:"Show incoming transaction notification"`
+	ShowNewBlockNotification            bool              `long:"newblocknotification" description:"Show new block notification"`
+	CurrencyConverter                   string            `long:"currencyconverter" description:"Currency Converter {none, bitrex}" choice:"none" choice:"bitrex" default:"none"`
+	HiddenAccounts                      []uint32          `long:"hiddenaccounts" description:"Accounts with ignored balances"`
+	DefaultAccount                      uint32            `long:"defaultaccount" description:"Default account for incoming and outgoing transactions"`
+	VSPAPIURL                           string            `long:"vspapiurl" description:"API URL of the voting service provider (stake pool) to use for ticket purchases"`
+	VSPAPIKey                           string            `long:"vspapikey" description:"API key issued by the voting service provider"`
+	VSPPoolAddress                      string            `long:"vsppooladdress" description:"Address the voting service provider's fees are paid to"`
+	VSPPoolFees                         float64           `long:"vsppoolfees" description:"Voting service provider fees in percent"`
+	VSPTicketAddress                    string            `long:"vspticketaddress" description:"Address to give voting rights to when purchasing tickets through the voting service provider"`
+	ExplorerTxURLs                      map[string]string `long:"explorertxurl" description:"Block explorer link for transactions on a network, in the form network:url where {hash} in the url is replaced with the transaction hash, e.g. testnet3:https://testnet.dcrdata.org/tx/{hash}. Set an empty url to hide links for the network."`
+	ExplorerAddressURLs                 map[string]string `long:"exploreraddressurl" description:"Block explorer link for addresses on a network, in the form network:url where {address} in the url is replaced with the address."`
+	SweepUTXOURLs                       map[string]string `long:"sweeputxourl" description:"Insight api link used to look up the unspent outputs of a private key being swept on a network, in the form network:url where {address} in the url is replaced with the key's address, e.g. simnet:http://127.0.0.1:17778/insight/api/addr/{address}/utxo. The address is sent to this link. Set an empty url to disable sweeping on the network."`
+	SPVConnect                          []string          `long:"spvconnect" description:"Only connect to this peer when syncing with spv, e.g. 127.0.0.1:19560 for a local simnet node. Can be set multiple times. Peers are discovered automatically if not set."`
 }
 
 func defaultFileOptions() ConfFileOptions {
 	return ConfFileOptions{
-		AppDataDir:    defaultAppDataDir,
-		WalletRPCCert: defaultRPCCertFile,
-		HTTPHost:      defaultHTTPHost,
-		HTTPPort:      defaultHTTPPort,
-		DebugLevel:    defaultLogLevel,
+		AppDataDir:         defaultAppDataDir,
+		WalletRPCCert:      defaultRPCCertFile,
+		SyncMode:           SyncModeSPV,
+		DcrdRPCCert:        defaultDcrdRPCCertFile,
+		HTTPHost:           defaultHTTPHost,
+		HTTPPort:           defaultHTTPPort,
+		HTTPSessionTimeout: defaultHTTPSessionTimeout,
+		DebugLevel:         defaultLogLevel,
+		LogFormat:          defaultLogFormat,
 		Settings: Settings{
 			CurrencyConverter: defaultCurrencyConverter,
 		},
@@ -5,25 +5,39 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/decred/dcrd/dcrutil"
 	flags "github.com/jessevdk/go-flags"
 )
 
 const (
-	defaultHTTPHost          = "127.0.0.1"
-	defaultHTTPPort          = "7778"
-	defaultLogLevel          = "info"
-	defaultCurrencyConverter = "none"
+	defaultHTTPHost           = "127.0.0.1"
+	defaultHTTPPort           = "7778"
+	defaultLogLevel           = "info"
+	defaultLogFormat          = "text"
+	defaultCurrencyConverter  = "none"
+	defaultHTTPSessionTimeout = 12 * time.Hour
 )
 
 var (
 	defaultAppDataDir          = dcrutil.AppDataDir("godcr", false)
 	DefaultDcrwalletAppDataDir = dcrutil.AppDataDir("dcrwallet", false)
 	defaultRPCCertFile         = filepath.Join(DefaultDcrwalletAppDataDir, "rpc.cert")
+	defaultDcrdRPCCertFile     = filepath.Join(dcrutil.AppDataDir("dcrd", false), "rpc.cert")
 	LogFile                    = filepath.Join(defaultAppDataDir, "logs/godcr.log")
 )
 
+// DefaultRPCClientCertFile returns the default path of the dcrwallet rpc client certificate in `appDataDir`
+func DefaultRPCClientCertFile(appDataDir string) string {
+	return filepath.Join(appDataDir, "rpc-client.cert")
+}
+
+// DefaultRPCClientKeyFile returns the default path of the dcrwallet rpc client key in `appDataDir`
+func DefaultRPCClientKeyFile(appDataDir string) string {
+	return filepath.Join(appDataDir, "rpc-client.key")
+}
+
 // Config holds the top-level options/flags for the application
 type Config struct {
 	ConfFileOptions
@@ -85,6 +99,7 @@ func LoadConfig() (*Config, []string, error) {
 
 	// if config file doesn't exist, no need to attempt to parse and then re-parse command-line args
 	if !configFileExists {
+		config.setAppDataDirDefaults()
 		return &config, unknownArgs, nil
 	}
 
@@ -101,11 +116,23 @@ func LoadConfig() (*Config, []string, error) {
 	// Parse command line options again to ensure they take precedence.
 	parser.Options = flags.IgnoreUnknown
 	unknownArgs, err = parser.Parse()
+	config.setAppDataDirDefaults()
 
 	// return parsed config, unknown args encountered and any error that occurred during last parsing
 	return &config, unknownArgs, err
 }
 
+// setAppDataDirDefaults sets the paths of files kept in the app data directory that were not configured,
+// using the configured app data directory rather than the default one
+func (options *ConfFileOptions) setAppDataDirDefaults() {
+	if options.HTTPCert == "" {
+		options.HTTPCert = filepath.Join(options.AppDataDir, "http.cert")
+	}
+	if options.HTTPKey == "" {
+		options.HTTPKey = filepath.Join(options.AppDataDir, "http.key")
+	}
+}
+
 // hasConfigFileOption checks if an unknown arg found in command-line is a config file option that should only be set in the config file
 func hasConfigFileOption(commandLineArgs []string) bool {
 	configFileOptions := configFileOptions()
@@ -0,0 +1,65 @@
+package config
+
+import "strings"
+
+const (
+	explorerTxHashPlaceholder  = "{hash}"
+	explorerAddressPlaceholder = "{address}"
+)
+
+// default block explorer links, keyed by network name as returned by walletcore.Wallet.NetType()
+var (
+	defaultExplorerTxURLs = map[string]string{
+		"mainnet":  "https://explorer.dcrdata.org/tx/" + explorerTxHashPlaceholder,
+		"testnet3": "https://testnet.dcrdata.org/tx/" + explorerTxHashPlaceholder,
+	}
+	defaultExplorerAddressURLs = map[string]string{
+		"mainnet":  "https://explorer.dcrdata.org/address/" + explorerAddressPlaceholder,
+		"testnet3": "https://testnet.dcrdata.org/address/" + explorerAddressPlaceholder,
+	}
+	defaultSweepUTXOURLs = map[string]string{
+		"mainnet":  "https://explorer.dcrdata.org/insight/api/addr/" + explorerAddressPlaceholder + "/utxo",
+		"testnet3": "https://testnet.dcrdata.org/insight/api/addr/" + explorerAddressPlaceholder + "/utxo",
+	}
+)
+
+// ExplorerTxURL returns the block explorer link for viewing the transaction with `txHash` on `netType`.
+// Returns an empty string if no explorer link is set for the network.
+func (settings *Settings) ExplorerTxURL(netType, txHash string) string {
+	return explorerURL(settings.ExplorerTxURLs, defaultExplorerTxURLs, netType, explorerTxHashPlaceholder, txHash)
+}
+
+// ExplorerAddressURL returns the block explorer link for viewing `address` on `netType`.
+// Returns an empty string if no explorer link is set for the network.
+func (settings *Settings) ExplorerAddressURL(netType, address string) string {
+	return explorerURL(settings.ExplorerAddressURLs, defaultExplorerAddressURLs, netType, explorerAddressPlaceholder, address)
+}
+
+// SweepUTXOURL returns the insight api link for looking up the unspent outputs of `address` on `netType`
+// when sweeping a private key. Returns an empty string if no lookup link is set for the network.
+func (settings *Settings) SweepUTXOURL(netType, address string) string {
+	return explorerURL(settings.SweepUTXOURLs, defaultSweepUTXOURLs, netType, explorerAddressPlaceholder, address)
+}
+
+// SweepUTXOService returns the lookup link used by SweepUTXOURL with the {address} placeholder in place,
+// so that users can be told where the address of a private key is sent before sweeping it.
+// Returns an empty string if no lookup link is set for the network.
+func (settings *Settings) SweepUTXOService(netType string) string {
+	return settings.SweepUTXOURL(netType, explorerAddressPlaceholder)
+}
+
+func explorerURL(urlTemplates, defaultURLTemplates map[string]string, netType, placeholder, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	urlTemplate, ok := urlTemplates[netType]
+	if !ok {
+		urlTemplate = defaultURLTemplates[netType]
+	}
+	if urlTemplate == "" {
+		return ""
+	}
+
+	return strings.Replace(urlTemplate, placeholder, value, -1)
+}
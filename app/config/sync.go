@@ -0,0 +1,82 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+)
+
+// sync modes that can be set with the syncmode config option
+const (
+	SyncModeSPV = "spv"
+	SyncModeRPC = "rpc"
+)
+
+// SyncOptions holds the config options that determine how wallet mediums sync the blockchain
+type SyncOptions struct {
+	Mode            string
+	DcrdRPCServer   string
+	DcrdRPCUser     string
+	DcrdRPCPassword string
+	DcrdRPCCert     string
+	SPVConnect      []string
+}
+
+// SyncOptions returns the sync options set in config
+func (options ConfFileOptions) SyncOptions() SyncOptions {
+	return SyncOptions{
+		Mode:            options.SyncMode,
+		DcrdRPCServer:   options.DcrdRPCServer,
+		DcrdRPCUser:     options.DcrdRPCUser,
+		DcrdRPCPassword: options.DcrdRPCPassword,
+		DcrdRPCCert:     options.DcrdRPCCert,
+		SPVConnect:      options.SPVConnect,
+	}
+}
+
+// UseDcrdRPC returns true if the blockchain should be synced through a dcrd node rather than spv peers
+func (options SyncOptions) UseDcrdRPC() bool {
+	return options.Mode == SyncModeRPC
+}
+
+// DcrdRPCCertificate checks that the dcrd rpc options required for rpc sync are set and returns the content of the dcrd certificate file
+func (options SyncOptions) DcrdRPCCertificate() ([]byte, error) {
+	if options.DcrdRPCServer == "" {
+		return nil, errors.New("set dcrdrpcserver in config file to sync through a dcrd node")
+	}
+	if options.DcrdRPCCert == "" {
+		return nil, errors.New("set dcrdrpccert in config file to sync through a dcrd node")
+	}
+
+	cert, err := ioutil.ReadFile(options.DcrdRPCCert)
+	if err != nil {
+		return nil, fmt.Errorf("error reading dcrd certificate: %s", err.Error())
+	}
+	return cert, nil
+}
+
+// ParsePeerAddresses splits `addresses` separated by commas, spaces or new lines into a list of peer addresses,
+// returning an error if any address is not a valid host or host:port.
+func ParsePeerAddresses(addresses string) ([]string, error) {
+	fields := strings.FieldsFunc(addresses, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n' || r == '\r' || r == '\t'
+	})
+
+	peers := make([]string, 0, len(fields))
+	for _, address := range fields {
+		host := address
+		if strings.Contains(address, ":") && !strings.HasSuffix(address, "]") {
+			var err error
+			if host, _, err = net.SplitHostPort(address); err != nil {
+				return nil, fmt.Errorf("invalid peer address %s: %s", address, err.Error())
+			}
+		}
+		if strings.Trim(host, "[]") == "" {
+			return nil, fmt.Errorf("invalid peer address %s: missing host", address)
+		}
+		peers = append(peers, address)
+	}
+	return peers, nil
+}
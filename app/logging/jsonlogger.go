@@ -0,0 +1,178 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/decred/slog"
+)
+
+// formats supported by the logformat config option
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Fields holds structured data to include in a log entry.
+// Pass Fields as one of the arguments of any log function, e.g. `log.Info("wallet opened", logging.Fields{"network": net})`.
+// JSON loggers write the fields as an object in the entry, text loggers append them to the message as key=value pairs.
+type Fields map[string]interface{}
+
+// jsonEntry is the structure of each line written by a JSON logger
+type jsonEntry struct {
+	Timestamp string `json:"timestamp"`
+	Level     string `json:"level"`
+	Subsystem string `json:"subsystem"`
+	Message   string `json:"message"`
+	Fields    Fields `json:"fields,omitempty"`
+}
+
+// JSONBackend writes log entries from the subsystem loggers created from it to a single writer, one JSON object per line.
+type JSONBackend struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJSONBackend creates a JSONBackend that writes to `w`
+func NewJSONBackend(w io.Writer) *JSONBackend {
+	return &JSONBackend{w: w}
+}
+
+// Logger returns a new slog.Logger for the `subsystem`, with the default info level
+func (backend *JSONBackend) Logger(subsystem string) slog.Logger {
+	return &jsonLogger{
+		backend:   backend,
+		subsystem: subsystem,
+		level:     uint32(slog.LevelInfo),
+	}
+}
+
+func (backend *JSONBackend) write(entry *jsonEntry) {
+	entryBytes, err := json.Marshal(entry)
+	if err != nil {
+		entryBytes, _ = json.Marshal(&jsonEntry{
+			Timestamp: entry.Timestamp,
+			Level:     entry.Level,
+			Subsystem: entry.Subsystem,
+			Message:   fmt.Sprintf("%s (fields could not be encoded: %s)", entry.Message, err.Error()),
+		})
+	}
+
+	backend.mu.Lock()
+	backend.w.Write(append(entryBytes, '\n'))
+	backend.mu.Unlock()
+}
+
+// jsonLogger implements slog.Logger, writing entries at or above its level to its backend
+type jsonLogger struct {
+	backend   *JSONBackend
+	subsystem string
+	level     uint32 // slog.Level, accessed atomically so levels can be changed while logging
+}
+
+func (l *jsonLogger) Level() slog.Level {
+	return slog.Level(atomic.LoadUint32(&l.level))
+}
+
+func (l *jsonLogger) SetLevel(level slog.Level) {
+	atomic.StoreUint32(&l.level, uint32(level))
+}
+
+func (l *jsonLogger) print(level slog.Level, args []interface{}) {
+	if level < l.Level() {
+		return
+	}
+	args, fields := extractFields(args)
+	l.write(level, fmt.Sprint(args...), fields)
+}
+
+func (l *jsonLogger) printf(level slog.Level, format string, args []interface{}) {
+	if level < l.Level() {
+		return
+	}
+	args, fields := extractFields(args)
+	l.write(level, fmt.Sprintf(format, args...), fields)
+}
+
+func (l *jsonLogger) write(level slog.Level, message string, fields Fields) {
+	l.backend.write(&jsonEntry{
+		Timestamp: time.Now().Format(time.RFC3339Nano),
+		Level:     levelName(level),
+		Subsystem: l.subsystem,
+		Message:   message,
+		Fields:    fields,
+	})
+}
+
+func (l *jsonLogger) Trace(args ...interface{}) {
+	l.print(slog.LevelTrace, args)
+}
+
+func (l *jsonLogger) Debug(args ...interface{}) {
+	l.print(slog.LevelDebug, args)
+}
+
+func (l *jsonLogger) Info(args ...interface{}) {
+	l.print(slog.LevelInfo, args)
+}
+
+func (l *jsonLogger) Warn(args ...interface{}) {
+	l.print(slog.LevelWarn, args)
+}
+
+func (l *jsonLogger) Error(args ...interface{}) {
+	l.print(slog.LevelError, args)
+}
+
+func (l *jsonLogger) Critical(args ...interface{}) {
+	l.print(slog.LevelCritical, args)
+}
+
+func (l *jsonLogger) Tracef(format string, args ...interface{}) {
+	l.printf(slog.LevelTrace, format, args)
+}
+
+func (l *jsonLogger) Debugf(format string, args ...interface{}) {
+	l.printf(slog.LevelDebug, format, args)
+}
+
+func (l *jsonLogger) Infof(format string, args ...interface{}) {
+	l.printf(slog.LevelInfo, format, args)
+}
+
+func (l *jsonLogger) Warnf(format string, args ...interface{}) {
+	l.printf(slog.LevelWarn, format, args)
+}
+
+func (l *jsonLogger) Errorf(format string, args ...interface{}) {
+	l.printf(slog.LevelError, format, args)
+}
+
+func (l *jsonLogger) Criticalf(format string, args ...interface{}) {
+	l.printf(slog.LevelCritical, format, args)
+}
+
+// extractFields separates any Fields passed as log arguments from the other arguments, merging them if there are several
+func extractFields(args []interface{}) ([]interface{}, Fields) {
+	var fields Fields
+	messageArgs := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		argFields, ok := arg.(Fields)
+		if !ok {
+			messageArgs = append(messageArgs, arg)
+			continue
+		}
+
+		if fields == nil {
+			fields = Fields{}
+		}
+		for key, value := range argFields {
+			fields[key] = value
+		}
+	}
+	return messageArgs, fields
+}
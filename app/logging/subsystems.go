@@ -0,0 +1,147 @@
+package logging
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/decred/slog"
+)
+
+// Levels lists the log levels that can be set for a subsystem, from the most to the least verbose
+var Levels = []string{"trace", "debug", "info", "warn", "error", "critical", "off"}
+
+var (
+	subsystemLoggersMu sync.RWMutex
+
+	// subsystemLoggers maps each subsystem identifier to its associated logger
+	subsystemLoggers = map[string]slog.Logger{}
+)
+
+// RegisterSubsystem makes the log level of `logger` configurable with the `subsystemID`
+func RegisterSubsystem(subsystemID string, logger slog.Logger) {
+	subsystemLoggersMu.Lock()
+	subsystemLoggers[subsystemID] = logger
+	subsystemLoggersMu.Unlock()
+}
+
+// Subsystems returns a sorted slice of the registered subsystems
+func Subsystems() []string {
+	subsystemLoggersMu.RLock()
+	defer subsystemLoggersMu.RUnlock()
+
+	subsystems := make([]string, 0, len(subsystemLoggers))
+	for subsystemID := range subsystemLoggers {
+		subsystems = append(subsystems, subsystemID)
+	}
+
+	// Sort the subsytems for stable display.
+	sort.Strings(subsystems)
+	return subsystems
+}
+
+// SubsystemLevels returns the current log level of each registered subsystem
+func SubsystemLevels() map[string]string {
+	subsystemLoggersMu.RLock()
+	defer subsystemLoggersMu.RUnlock()
+
+	levels := make(map[string]string, len(subsystemLoggers))
+	for subsystemID, logger := range subsystemLoggers {
+		levels[subsystemID] = levelName(logger.Level())
+	}
+	return levels
+}
+
+// DebugLevel returns the current log levels of all subsystems in the format of the debuglevel config option.
+// A single level is returned if all subsystems log at the same level.
+func DebugLevel() string {
+	subsystemLevels := SubsystemLevels()
+	subsystems := Subsystems()
+
+	levelPairs := make([]string, len(subsystems))
+	sameLevel := true
+	for i, subsystemID := range subsystems {
+		levelPairs[i] = subsystemID + "=" + subsystemLevels[subsystemID]
+		if subsystemLevels[subsystemID] != subsystemLevels[subsystems[0]] {
+			sameLevel = false
+		}
+	}
+
+	if sameLevel && len(subsystems) > 0 {
+		return subsystemLevels[subsystems[0]]
+	}
+	return strings.Join(levelPairs, ",")
+}
+
+// levelName returns the name used to set `level`, slog.Level.String() returns abbreviated names
+func levelName(level slog.Level) string {
+	for _, name := range Levels {
+		if nameLevel, _ := slog.LevelFromString(name); nameLevel == level {
+			return name
+		}
+	}
+	return strings.ToLower(level.String())
+}
+
+// SetLevel sets the log level of the subsystem, returning an error if the subsystem or level is invalid.
+// Levels are changed immediately, including while the subsystem is logging.
+func SetLevel(subsystemID, logLevel string) error {
+	subsystemLoggersMu.RLock()
+	logger, ok := subsystemLoggers[subsystemID]
+	subsystemLoggersMu.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("The specified subsystem [%v] is invalid -- supported subsytems %v", subsystemID, Subsystems())
+	}
+
+	level, ok := slog.LevelFromString(logLevel)
+	if !ok {
+		return fmt.Errorf("The specified debug level [%v] is invalid", logLevel)
+	}
+	logger.SetLevel(level)
+	return nil
+}
+
+// SetLevels sets the log level of all subsystems
+func SetLevels(logLevel string) error {
+	if _, ok := slog.LevelFromString(logLevel); !ok {
+		return fmt.Errorf("The specified debug level [%v] is invalid", logLevel)
+	}
+
+	for _, subsystemID := range Subsystems() {
+		if err := SetLevel(subsystemID, logLevel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseAndSetDebugLevels attempts to parse the specified debug level and set
+// the levels accordingly.  An appropriate error is returned if anything is
+// invalid.
+func ParseAndSetDebugLevels(debugLevel string) error {
+	// When the specified string doesn't have any delimters, treat it as
+	// the log level for all subsystems.
+	if !strings.Contains(debugLevel, ",") && !strings.Contains(debugLevel, "=") {
+		return SetLevels(debugLevel)
+	}
+
+	// Split the specified string into subsystem/level pairs while detecting
+	// issues and update the log levels accordingly.
+	for _, logLevelPair := range strings.Split(debugLevel, ",") {
+		if !strings.Contains(logLevelPair, "=") {
+			str := "The specified debug level contains an invalid " +
+				"subsystem/level pair [%v]"
+			return fmt.Errorf(str, logLevelPair)
+		}
+
+		// Extract the specified subsystem and log level.
+		fields := strings.Split(logLevelPair, "=")
+		if err := SetLevel(fields[0], fields[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
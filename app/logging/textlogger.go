@@ -0,0 +1,93 @@
+package logging
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/decred/slog"
+)
+
+// textLogger wraps a logger created from a slog text backend to write Fields passed as log arguments as key=value pairs
+type textLogger struct {
+	slog.Logger
+}
+
+// NewTextLogger wraps `logger`, created from a slog text backend, so that Fields are appended to log messages
+func NewTextLogger(logger slog.Logger) slog.Logger {
+	return textLogger{logger}
+}
+
+// withFields formats `args` into a message followed by any Fields in them
+func withFields(args []interface{}) string {
+	args, fields := extractFields(args)
+	return fmt.Sprint(args...) + fields.String()
+}
+
+func withFieldsf(format string, args []interface{}) string {
+	args, fields := extractFields(args)
+	return fmt.Sprintf(format, args...) + fields.String()
+}
+
+// String returns the fields as space separated key=value pairs sorted by key, with a leading space if there are any fields
+func (fields Fields) String() string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var builder strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&builder, " %s=%v", key, fields[key])
+	}
+	return builder.String()
+}
+
+func (l textLogger) Trace(args ...interface{}) {
+	l.Logger.Trace(withFields(args))
+}
+
+func (l textLogger) Debug(args ...interface{}) {
+	l.Logger.Debug(withFields(args))
+}
+
+func (l textLogger) Info(args ...interface{}) {
+	l.Logger.Info(withFields(args))
+}
+
+func (l textLogger) Warn(args ...interface{}) {
+	l.Logger.Warn(withFields(args))
+}
+
+func (l textLogger) Error(args ...interface{}) {
+	l.Logger.Error(withFields(args))
+}
+
+func (l textLogger) Critical(args ...interface{}) {
+	l.Logger.Critical(withFields(args))
+}
+
+func (l textLogger) Tracef(format string, args ...interface{}) {
+	l.Logger.Trace(withFieldsf(format, args))
+}
+
+func (l textLogger) Debugf(format string, args ...interface{}) {
+	l.Logger.Debug(withFieldsf(format, args))
+}
+
+func (l textLogger) Infof(format string, args ...interface{}) {
+	l.Logger.Info(withFieldsf(format, args))
+}
+
+func (l textLogger) Warnf(format string, args ...interface{}) {
+	l.Logger.Warn(withFieldsf(format, args))
+}
+
+func (l textLogger) Errorf(format string, args ...interface{}) {
+	l.Logger.Error(withFieldsf(format, args))
+}
+
+func (l textLogger) Criticalf(format string, args ...interface{}) {
+	l.Logger.Critical(withFieldsf(format, args))
+}
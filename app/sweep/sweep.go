@@ -0,0 +1,204 @@
+package sweep
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainec"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrd/txscript"
+	"github.com/decred/dcrd/wire"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/dcrlibwallet/utils"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+var requestTimeout = 30 * time.Second
+
+// UnspentOutput is an unspent output paying to the address of the key being swept
+type UnspentOutput struct {
+	TransactionHash string  `json:"txid"`
+	OutputIndex     uint32  `json:"vout"`
+	ScriptPubKey    string  `json:"scriptPubKey"`
+	Amount          float64 `json:"amount"`
+	Confirmations   int64   `json:"confirmations"`
+}
+
+// Result describes a sweep transaction
+type Result struct {
+	TransactionHash string
+	SourceAddress   string
+	NumInputs       int
+	TotalAmount     dcrutil.Amount
+}
+
+// Sweep finds all unspent outputs paying to the address of the WIF-encoded private key,
+// creates a transaction that sends everything (less fees) to a new address in `destinationAccount`,
+// signs the transaction using the private key and publishes it. The key is not imported into the wallet.
+// The wallet only tracks outputs for its own addresses, so the outputs are looked up from the insight api link
+// returned by `utxoURL` for the key's address. Callers should tell users where the address is sent before sweeping.
+func Sweep(ctx context.Context, wallet walletcore.Wallet, privateKeyWif string, destinationAccount uint32,
+	utxoURL func(address string) string) (*Result, error) {
+	netParams := utils.NetParams(wallet.NetType())
+	if netParams == nil {
+		return nil, fmt.Errorf("unsupported network: %s", wallet.NetType())
+	}
+
+	wif, err := dcrutil.DecodeWIF(strings.TrimSpace(privateKeyWif))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %s", err.Error())
+	}
+	if !wif.IsForNet(netParams.Params) {
+		return nil, fmt.Errorf("private key is not for %s", netParams.Name)
+	}
+
+	sourceAddress, err := dcrutil.NewAddressPubKeyHash(dcrutil.Hash160(wif.SerializePubKey()), netParams.Params, wif.DSA())
+	if err != nil {
+		return nil, fmt.Errorf("error deriving address for private key: %s", err.Error())
+	}
+
+	lookupURL := utxoURL(sourceAddress.EncodeAddress())
+	if lookupURL == "" {
+		return nil, fmt.Errorf("no link is set to look up unspent outputs on %s, set sweeputxourl in the config file to sweep private keys", netParams.Name)
+	}
+
+	utxos, err := FetchUnspentOutputs(ctx, lookupURL, sourceAddress.EncodeAddress())
+	if err != nil {
+		return nil, err
+	}
+	if len(utxos) == 0 {
+		return nil, fmt.Errorf("no unspent outputs found for address %s", sourceAddress.EncodeAddress())
+	}
+
+	inputs := make([]*wire.TxIn, len(utxos))
+	pkScripts := make([][]byte, len(utxos))
+	var totalAmount dcrutil.Amount
+	for i, utxo := range utxos {
+		transactionHash, err := chainhash.NewHashFromStr(utxo.TransactionHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid utxo transaction hash: %s", err.Error())
+		}
+
+		amount, err := dcrutil.NewAmount(utxo.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid utxo amount: %s", err.Error())
+		}
+
+		pkScripts[i], err = hex.DecodeString(utxo.ScriptPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid utxo script: %s", err.Error())
+		}
+
+		tree, err := outputTree(pkScripts[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot sweep output %s:%d: %s", utxo.TransactionHash, utxo.OutputIndex, err.Error())
+		}
+
+		outpoint := wire.NewOutPoint(transactionHash, utxo.OutputIndex, tree)
+		inputs[i] = wire.NewTxIn(outpoint, int64(amount), nil)
+		totalAmount += amount
+	}
+
+	destinationAddress, err := wallet.GenerateNewAddress(destinationAccount)
+	if err != nil {
+		return nil, fmt.Errorf("error generating destination address: %s", err.Error())
+	}
+
+	destinations := []txhelper.TransactionDestination{{
+		Address: destinationAddress,
+		SendMax: true,
+	}}
+	tx, err := txhelper.NewUnsignedTx(inputs, destinations, nil, func() (string, error) {
+		return wallet.GenerateNewAddress(destinationAccount)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	getKey := txscript.KeyClosure(func(dcrutil.Address) (chainec.PrivateKey, bool, error) {
+		return wif.PrivKey, true, nil
+	})
+	for i := range tx.TxIn {
+		sigScript, err := txscript.SignTxOutput(netParams.Params, tx, i, pkScripts[i], txscript.SigHashAll, getKey, nil, nil, wif.DSA())
+		if err != nil {
+			return nil, fmt.Errorf("error signing input %d: %s", i, err.Error())
+		}
+		tx.TxIn[i].SignatureScript = sigScript
+	}
+
+	var txBuf bytes.Buffer
+	txBuf.Grow(tx.SerializeSize())
+	if err = tx.Serialize(&txBuf); err != nil {
+		return nil, fmt.Errorf("error serializing transaction: %s", err.Error())
+	}
+
+	txHash, err := wallet.PublishTransaction(ctx, txBuf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		TransactionHash: txHash,
+		SourceAddress:   sourceAddress.EncodeAddress(),
+		NumInputs:       len(inputs),
+		TotalAmount:     totalAmount,
+	}, nil
+}
+
+// outputTree returns the tree of the transaction that created an output with `pkScript`.
+// The insight api does not report the tree of unspent outputs, so only untagged p2pkh outputs are swept:
+// outputs of stake tree transactions are always tagged with a stake opcode, so an untagged p2pkh output is in the regular tree.
+func outputTree(pkScript []byte) (int8, error) {
+	switch scriptClass := txscript.GetScriptClass(txscript.DefaultScriptVersion, pkScript); scriptClass {
+	case txscript.PubKeyHashTy, txscript.PubkeyHashAltTy:
+		return wire.TxTreeRegular, nil
+	default:
+		return 0, fmt.Errorf("the output's tree cannot be determined for %s scripts, only p2pkh outputs can be swept", scriptClass)
+	}
+}
+
+// FetchUnspentOutputs returns the unspent outputs paying to `address` from the insight api link `lookupURL`
+func FetchUnspentOutputs(ctx context.Context, lookupURL, address string) ([]*UnspentOutput, error) {
+	req, err := http.NewRequest(http.MethodGet, lookupURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{Timeout: requestTimeout}
+	res, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching unspent outputs for %s: %s", address, err.Error())
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching unspent outputs for %s: lookup returned %s", address, res.Status)
+	}
+
+	var utxos []*UnspentOutput
+	if err = json.NewDecoder(res.Body).Decode(&utxos); err != nil {
+		return nil, fmt.Errorf("error reading unspent outputs for %s: %s", address, err.Error())
+	}
+
+	// only spend outputs that can't be invalidated by a reorg or stakeholder vote
+	spendableUtxos := make([]*UnspentOutput, 0, len(utxos))
+	for _, utxo := range utxos {
+		if utxo.Confirmations >= walletcore.DefaultRequiredConfirmations {
+			spendableUtxos = append(spendableUtxos, utxo)
+		}
+	}
+
+	if len(utxos) > 0 && len(spendableUtxos) == 0 {
+		return nil, errors.New("unspent outputs for the private key do not have enough confirmations yet")
+	}
+
+	return spendableUtxos, nil
+}
@@ -0,0 +1,192 @@
+package vsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app/config"
+)
+
+// PurchaseInfoPath is the voting service provider api endpoint that returns the details needed to purchase tickets through the vsp
+const PurchaseInfoPath = "/api/v2/getpurchaseinfo"
+
+var requestTimeout = 10 * time.Second
+
+// Config holds the details of a voting service provider (stake pool) needed to purchase tickets through the vsp
+type Config struct {
+	APIURL        string
+	APIKey        string
+	PoolAddress   string
+	PoolFees      float64
+	TicketAddress string
+}
+
+// PurchaseInfo is the purchase information returned by a voting service provider for the user's api key
+type PurchaseInfo struct {
+	PoolAddress   string  `json:"PoolAddress"`
+	PoolFees      float64 `json:"PoolFees"`
+	Script        string  `json:"Script"`
+	TicketAddress string  `json:"TicketAddress"`
+}
+
+// apiResponse is the response envelope used by voting service providers for all api responses
+type apiResponse struct {
+	Status  string          `json:"status"`
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// ConfigFromSettings returns the vsp config saved in the godcr config file.
+// Returns nil if no vsp has been configured.
+func ConfigFromSettings(settings *config.Settings) *Config {
+	if settings.VSPAPIURL == "" || settings.VSPPoolAddress == "" {
+		return nil
+	}
+
+	return &Config{
+		APIURL:        settings.VSPAPIURL,
+		APIKey:        settings.VSPAPIKey,
+		PoolAddress:   settings.VSPPoolAddress,
+		PoolFees:      settings.VSPPoolFees,
+		TicketAddress: settings.VSPTicketAddress,
+	}
+}
+
+// ReadConfig reads the vsp config from the godcr config file.
+// Returns nil without any error if no vsp has been configured.
+func ReadConfig() (*Config, error) {
+	cfg, err := config.ReadConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file: %s", err.Error())
+	}
+	return ConfigFromSettings(&cfg.Settings), nil
+}
+
+// SaveConfig writes the provided vsp config to the godcr config file and updates `settings` if it is not nil.
+// Passing a nil vspConfig removes any previously saved vsp config.
+func SaveConfig(vspConfig *Config, settings *config.Settings) error {
+	if vspConfig == nil {
+		vspConfig = &Config{}
+	}
+
+	updateSettings := func(settings *config.Settings) {
+		settings.VSPAPIURL = vspConfig.APIURL
+		settings.VSPAPIKey = vspConfig.APIKey
+		settings.VSPPoolAddress = vspConfig.PoolAddress
+		settings.VSPPoolFees = vspConfig.PoolFees
+		settings.VSPTicketAddress = vspConfig.TicketAddress
+	}
+
+	err := config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+		updateSettings(&cnfg.Settings)
+	})
+	if err != nil {
+		return err
+	}
+
+	if settings != nil {
+		updateSettings(settings)
+	}
+	return nil
+}
+
+// Setup fetches the purchase info for `apiKey` from the vsp at `apiURL` and returns a config that can be saved and used for ticket purchases.
+// `validateAddress` is used to ensure that the addresses returned by the vsp are valid for the wallet's network.
+// `importScript` is called with the hex-encoded redeem script of the vsp's ticket address, which must be imported into the wallet
+// for the wallet to track and vote tickets purchased through the vsp.
+func Setup(ctx context.Context, apiURL, apiKey string, validateAddress func(address string) (bool, error),
+	importScript func(scriptHex string) error) (*Config, error) {
+	apiURL = strings.TrimRight(strings.TrimSpace(apiURL), "/")
+	if apiURL == "" {
+		return nil, errors.New("vsp api url is required")
+	}
+	if apiKey == "" {
+		return nil, errors.New("vsp api key is required")
+	}
+
+	purchaseInfo, err := FetchPurchaseInfo(ctx, apiURL, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, address := range []string{purchaseInfo.PoolAddress, purchaseInfo.TicketAddress} {
+		isValid, err := validateAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("error validating vsp address %s: %s", address, err.Error())
+		}
+		if !isValid {
+			return nil, fmt.Errorf("vsp returned an address that is not valid for this wallet's network: %s", address)
+		}
+	}
+
+	if purchaseInfo.Script == "" {
+		return nil, errors.New("vsp did not return the redeem script of the ticket address")
+	}
+	if err = importScript(purchaseInfo.Script); err != nil {
+		return nil, fmt.Errorf("error importing vsp ticket script: %s", err.Error())
+	}
+
+	return &Config{
+		APIURL:        apiURL,
+		APIKey:        apiKey,
+		PoolAddress:   purchaseInfo.PoolAddress,
+		PoolFees:      purchaseInfo.PoolFees,
+		TicketAddress: purchaseInfo.TicketAddress,
+	}, nil
+}
+
+// FetchPurchaseInfo requests the ticket purchase information for the user identified by `apiKey` from the vsp at `apiURL`
+func FetchPurchaseInfo(ctx context.Context, apiURL, apiKey string) (*PurchaseInfo, error) {
+	req, err := http.NewRequest(http.MethodGet, strings.TrimRight(apiURL, "/")+PurchaseInfoPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vsp api url: %s", err.Error())
+	}
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+
+	client := &http.Client{Timeout: requestTimeout}
+	res, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error contacting vsp: %s", err.Error())
+	}
+	defer res.Body.Close()
+
+	var response apiResponse
+	if err = json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("error reading vsp response (http status %d): %s", res.StatusCode, err.Error())
+	}
+
+	if response.Status != "success" {
+		return nil, fmt.Errorf("vsp returned an error: %s", response.Message)
+	}
+
+	var purchaseInfo PurchaseInfo
+	if err = json.Unmarshal(response.Data, &purchaseInfo); err != nil {
+		return nil, fmt.Errorf("error reading vsp purchase info: %s", err.Error())
+	}
+
+	if purchaseInfo.PoolAddress == "" || purchaseInfo.TicketAddress == "" {
+		return nil, errors.New("vsp did not return the pool and ticket addresses for this api key")
+	}
+
+	return &purchaseInfo, nil
+}
+
+// ApplyToPurchaseRequest sets the vsp pool and ticket addresses and pool fees on a ticket purchase request
+func (vspConfig *Config) ApplyToPurchaseRequest(request *dcrlibwallet.PurchaseTicketsRequest) {
+	request.PoolAddress = vspConfig.PoolAddress
+	request.PoolFees = vspConfig.PoolFees
+	request.TicketAddress = vspConfig.TicketAddress
+}
+
+// Host returns the host part of the vsp api url for display purposes
+func (vspConfig *Config) Host() string {
+	host := strings.TrimPrefix(strings.TrimPrefix(vspConfig.APIURL, "https://"), "http://")
+	return strings.Split(host, "/")[0]
+}
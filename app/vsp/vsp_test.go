@@ -0,0 +1,208 @@
+package vsp_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/raedahgroup/godcr/app/vsp"
+	"github.com/raedahgroup/godcr/app/vsp/vsptest"
+)
+
+const testAPIKey = "test-api-key"
+
+var testPurchaseInfo = vsp.PurchaseInfo{
+	PoolAddress:   "TsbyH2p611jSWnvUAq3erSsRYnCxBg3nT2S",
+	PoolFees:      7.5,
+	Script:        "512103af3c24d005ca8b755e7167617f3a5b4c60a65f8318a7fcd1b0cacb1abd2a97fc21027b81bc16954e28adb832248140eb58bedb6078ae5f4dabf21fde5a8ab7135cb652ae",
+	TicketAddress: "Tcbvn2hiEAXBDwUPDLDG2SxF9iANMKhdVev",
+}
+
+// validAddresses returns an address validator that accepts only `addresses`
+func validAddresses(addresses ...string) func(string) (bool, error) {
+	return func(address string) (bool, error) {
+		for _, validAddress := range addresses {
+			if address == validAddress {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// importNothing is an importScript function for tests that fail before the script is imported
+func importNothing(string) error {
+	return nil
+}
+
+func TestSetup(t *testing.T) {
+	server := vsptest.NewServer(testAPIKey, testPurchaseInfo)
+	defer server.Close()
+
+	var importedScripts []string
+	importScript := func(scriptHex string) error {
+		importedScripts = append(importedScripts, scriptHex)
+		return nil
+	}
+
+	validateAddress := validAddresses(testPurchaseInfo.PoolAddress, testPurchaseInfo.TicketAddress)
+	vspConfig, err := vsp.Setup(context.Background(), server.URL+"/", testAPIKey, validateAddress, importScript)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(vspConfig, server.Config()) {
+		t.Errorf("expected config %+v, got %+v", server.Config(), vspConfig)
+	}
+	if !reflect.DeepEqual(importedScripts, []string{testPurchaseInfo.Script}) {
+		t.Errorf("expected the vsp script %s to be imported once, imported %v", testPurchaseInfo.Script, importedScripts)
+	}
+}
+
+func TestSetupScriptImportErrors(t *testing.T) {
+	validateAddress := validAddresses(testPurchaseInfo.PoolAddress, testPurchaseInfo.TicketAddress)
+
+	t.Run("import fails", func(t *testing.T) {
+		server := vsptest.NewServer(testAPIKey, testPurchaseInfo)
+		defer server.Close()
+
+		importScript := func(string) error {
+			return errors.New("invalid passphrase")
+		}
+		_, err := vsp.Setup(context.Background(), server.URL, testAPIKey, validateAddress, importScript)
+		if err == nil || !strings.Contains(err.Error(), "invalid passphrase") {
+			t.Errorf("expected script import error, got %v", err)
+		}
+	})
+
+	t.Run("missing script", func(t *testing.T) {
+		purchaseInfo := testPurchaseInfo
+		purchaseInfo.Script = ""
+		server := vsptest.NewServer(testAPIKey, purchaseInfo)
+		defer server.Close()
+
+		importScript := func(string) error {
+			t.Error("expected no script to be imported")
+			return nil
+		}
+		_, err := vsp.Setup(context.Background(), server.URL, testAPIKey, validateAddress, importScript)
+		if err == nil || !strings.Contains(err.Error(), "redeem script") {
+			t.Errorf("expected missing script error, got %v", err)
+		}
+	})
+}
+
+func TestSetupErrors(t *testing.T) {
+	server := vsptest.NewServer(testAPIKey, testPurchaseInfo)
+	defer server.Close()
+
+	tests := []struct {
+		name            string
+		apiURL          string
+		apiKey          string
+		validateAddress func(string) (bool, error)
+		expectedError   string
+	}{
+		{
+			name:            "missing api url",
+			apiURL:          " ",
+			apiKey:          testAPIKey,
+			validateAddress: validAddresses(testPurchaseInfo.PoolAddress, testPurchaseInfo.TicketAddress),
+			expectedError:   "vsp api url is required",
+		},
+		{
+			name:            "missing api key",
+			apiURL:          server.URL,
+			validateAddress: validAddresses(testPurchaseInfo.PoolAddress, testPurchaseInfo.TicketAddress),
+			expectedError:   "vsp api key is required",
+		},
+		{
+			name:            "bad api key",
+			apiURL:          server.URL,
+			apiKey:          "wrong-api-key",
+			validateAddress: validAddresses(testPurchaseInfo.PoolAddress, testPurchaseInfo.TicketAddress),
+			expectedError:   "invalid api key",
+		},
+		{
+			name:            "pool address for another network",
+			apiURL:          server.URL,
+			apiKey:          testAPIKey,
+			validateAddress: validAddresses(testPurchaseInfo.TicketAddress),
+			expectedError:   testPurchaseInfo.PoolAddress,
+		},
+		{
+			name:            "ticket address for another network",
+			apiURL:          server.URL,
+			apiKey:          testAPIKey,
+			validateAddress: validAddresses(testPurchaseInfo.PoolAddress),
+			expectedError:   testPurchaseInfo.TicketAddress,
+		},
+		{
+			name:   "address validation error",
+			apiURL: server.URL,
+			apiKey: testAPIKey,
+			validateAddress: func(string) (bool, error) {
+				return false, errors.New("wallet not open")
+			},
+			expectedError: "wallet not open",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vspConfig, err := vsp.Setup(context.Background(), test.apiURL, test.apiKey, test.validateAddress, importNothing)
+			if err == nil {
+				t.Fatalf("expected an error, got config %+v", vspConfig)
+			}
+			if !strings.Contains(err.Error(), test.expectedError) {
+				t.Errorf("expected error containing %q, got %q", test.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestFetchPurchaseInfo(t *testing.T) {
+	server := vsptest.NewServer(testAPIKey, testPurchaseInfo)
+	defer server.Close()
+
+	purchaseInfo, err := vsp.FetchPurchaseInfo(context.Background(), server.URL, testAPIKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *purchaseInfo != testPurchaseInfo {
+		t.Errorf("expected purchase info %+v, got %+v", testPurchaseInfo, *purchaseInfo)
+	}
+}
+
+func TestFetchPurchaseInfoErrors(t *testing.T) {
+	t.Run("bad api key", func(t *testing.T) {
+		server := vsptest.NewServer(testAPIKey, testPurchaseInfo)
+		defer server.Close()
+
+		_, err := vsp.FetchPurchaseInfo(context.Background(), server.URL, "wrong-api-key")
+		if err == nil || !strings.Contains(err.Error(), "invalid api key") {
+			t.Errorf("expected invalid api key error, got %v", err)
+		}
+	})
+
+	t.Run("missing addresses", func(t *testing.T) {
+		server := vsptest.NewServer(testAPIKey, vsp.PurchaseInfo{PoolFees: testPurchaseInfo.PoolFees})
+		defer server.Close()
+
+		_, err := vsp.FetchPurchaseInfo(context.Background(), server.URL, testAPIKey)
+		if err == nil || !strings.Contains(err.Error(), "did not return the pool and ticket addresses") {
+			t.Errorf("expected missing addresses error, got %v", err)
+		}
+	})
+
+	t.Run("unreachable vsp", func(t *testing.T) {
+		server := vsptest.NewServer(testAPIKey, testPurchaseInfo)
+		server.Close()
+
+		_, err := vsp.FetchPurchaseInfo(context.Background(), server.URL, testAPIKey)
+		if err == nil || !strings.Contains(err.Error(), "error contacting vsp") {
+			t.Errorf("expected connection error, got %v", err)
+		}
+	})
+}
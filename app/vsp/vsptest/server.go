@@ -0,0 +1,65 @@
+// Package vsptest provides a local stand-in for a voting service provider's api,
+// for use when testing ticket purchases through a vsp without contacting a real stake pool.
+package vsptest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/raedahgroup/godcr/app/vsp"
+)
+
+// Server is a local voting service provider api server that responds to purchase info requests
+// with PurchaseInfo if the request is authorized with APIKey.
+type Server struct {
+	*httptest.Server
+	APIKey       string
+	PurchaseInfo vsp.PurchaseInfo
+}
+
+// NewServer starts and returns a stand-in vsp server. The caller should call Close when done.
+func NewServer(apiKey string, purchaseInfo vsp.PurchaseInfo) *Server {
+	server := &Server{
+		APIKey:       apiKey,
+		PurchaseInfo: purchaseInfo,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(vsp.PurchaseInfoPath, server.purchaseInfoHandler)
+	server.Server = httptest.NewServer(mux)
+
+	return server
+}
+
+// Config returns the vsp config that should be produced by setting up a vsp using this server's url and api key
+func (server *Server) Config() *vsp.Config {
+	return &vsp.Config{
+		APIURL:        server.URL,
+		APIKey:        server.APIKey,
+		PoolAddress:   server.PurchaseInfo.PoolAddress,
+		PoolFees:      server.PurchaseInfo.PoolFees,
+		TicketAddress: server.PurchaseInfo.TicketAddress,
+	}
+}
+
+func (server *Server) purchaseInfoHandler(res http.ResponseWriter, req *http.Request) {
+	res.Header().Set("Content-Type", "application/json")
+
+	if req.Header.Get("Authorization") != "Bearer "+server.APIKey {
+		res.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(res).Encode(map[string]interface{}{
+			"status":  "error",
+			"code":    9,
+			"message": "invalid api key",
+		})
+		return
+	}
+
+	json.NewEncoder(res).Encode(map[string]interface{}{
+		"status":  "success",
+		"code":    0,
+		"message": "purchase info",
+		"data":    server.PurchaseInfo,
+	})
+}
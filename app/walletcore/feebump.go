@@ -0,0 +1,123 @@
+package walletcore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrd/txscript"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet/txrules"
+)
+
+// estimated serialized sizes used to calculate the fee of a child transaction that spends a single p2pkh output
+// to a single p2pkh output, which is the only kind of child transaction created to bump a fee
+const (
+	// version, lock time, expiry and the input, output and witness counts
+	txOverheadSize = 4 + 4 + 4 + 1 + 1 + 1
+
+	// outpoint, tree, sequence, value in, block height, block index and signature script (with its length)
+	redeemP2PKHInputSize = 32 + 4 + 1 + 4 + 8 + 4 + 4 + 1 + 108
+
+	// value, script version and p2pkh script (with its length)
+	p2pkhOutputSize = 8 + 2 + 1 + 25
+
+	cpfpTxSize = txOverheadSize + redeemP2PKHInputSize + p2pkhOutputSize
+)
+
+// ErrTransactionMined is returned when attempting to bump the fee of, or abandon, a transaction that is already mined
+var ErrTransactionMined = errors.New("transaction is already mined")
+
+// NewCPFPTransaction creates an unsigned child transaction that spends the largest output of the unmined `parentTx`
+// that belongs to this wallet, paying a fee high enough for the parent and child transactions together
+// to have a fee rate of `feeRate` atoms/kB (child pays for parent).
+// The child transaction's only output pays to a new address generated by `generateAddress` for the account that owns the spent output.
+func NewCPFPTransaction(parentTx *Transaction, feeRate int64, generateAddress func(account uint32) (string, error)) (*wire.MsgTx, error) {
+	if err := checkFeeBumpable(parentTx, feeRate); err != nil {
+		return nil, err
+	}
+
+	outputIndex := walletOutputIndex(parentTx)
+	if outputIndex < 0 {
+		return nil, errors.New("transaction has no output belonging to this wallet that can be spent to bump its fee")
+	}
+	output := parentTx.Outputs[outputIndex]
+
+	parentHash, err := chainhash.NewHashFromStr(parentTx.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction hash: %s", err.Error())
+	}
+
+	address, err := generateAddress(uint32(output.AccountNumber))
+	if err != nil {
+		return nil, fmt.Errorf("error generating address: %s", err.Error())
+	}
+	pkScript, err := addressPkScript(address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the child must pay the extra fee required by the parent and still pay `feeRate` for its own size
+	childFee := feeForSize(feeRate, parentTx.Size+cpfpTxSize) - parentTx.Fee
+	if minChildFee := feeForSize(feeRate, cpfpTxSize); childFee < minChildFee {
+		childFee = minChildFee
+	}
+
+	childAmount := output.Amount - childFee
+	if childAmount <= 0 || txrules.IsDustAmount(dcrutil.Amount(childAmount), len(pkScript), txrules.DefaultRelayFeePerKb) {
+		return nil, fmt.Errorf("output %d of %s is too small to pay the required fee of %s",
+			output.Index, dcrutil.Amount(output.Amount), dcrutil.Amount(childFee))
+	}
+
+	childTx := wire.NewMsgTx()
+	childTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(parentHash, uint32(output.Index), wire.TxTreeRegular), output.Amount, nil))
+	childTx.AddTxOut(wire.NewTxOut(childAmount, pkScript))
+	return childTx, nil
+}
+
+// CheckAbandonable returns an error if `tx` cannot be abandoned because it is already mined
+func CheckAbandonable(tx *Transaction) error {
+	if tx.BlockHeight > 0 || tx.Confirmations > 0 {
+		return ErrTransactionMined
+	}
+	return nil
+}
+
+func checkFeeBumpable(tx *Transaction, feeRate int64) error {
+	if err := CheckAbandonable(tx); err != nil {
+		return err
+	}
+	if feeRate <= tx.FeeRate {
+		return fmt.Errorf("new fee rate must be higher than the current fee rate of %s/kB", dcrutil.Amount(tx.FeeRate))
+	}
+	return nil
+}
+
+// walletOutputIndex returns the position in `tx.Outputs` of the largest output that belongs to this wallet, or -1 if there is none.
+// Use the output's Index field, not this position, to refer to the output in the transaction.
+func walletOutputIndex(tx *Transaction) int {
+	index := -1
+	for i, output := range tx.Outputs {
+		if output.AccountNumber == -1 {
+			continue
+		}
+		if index == -1 || output.Amount > tx.Outputs[index].Amount {
+			index = i
+		}
+	}
+	return index
+}
+
+func addressPkScript(address string) ([]byte, error) {
+	addr, err := dcrutil.DecodeAddress(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %s: %s", address, err.Error())
+	}
+	return txscript.PayToAddrScript(addr)
+}
+
+// feeForSize returns the fee in atoms for a transaction of `size` bytes at `feeRate` atoms/kB
+func feeForSize(feeRate int64, size int) int64 {
+	return feeRate * int64(size) / 1000
+}
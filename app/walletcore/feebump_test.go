@@ -0,0 +1,209 @@
+package walletcore
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/decred/dcrd/wire"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+)
+
+const (
+	testTxHash  = "8d5d2bac9a3d1b8b4a4a9d4e2b5cbfc4ad6ec5c1c0b8a0e5e0c3b9f5d6e7a8b9"
+	testAddress = "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu"
+)
+
+// unminedTx returns an unmined transaction of 250 bytes paying a fee rate of 10000 atoms/kB,
+// with an output to an external address followed by `walletOutputs`
+func unminedTx(walletOutputs ...*txhelper.TxOutput) *Transaction {
+	outputs := []*txhelper.TxOutput{{Index: 0, Amount: 500000000, AccountNumber: -1}}
+	outputs = append(outputs, walletOutputs...)
+	return &Transaction{
+		Transaction: &txhelper.Transaction{
+			Hash:    testTxHash,
+			Size:    250,
+			Fee:     2500,
+			FeeRate: 10000,
+			Outputs: outputs,
+		},
+	}
+}
+
+func generateTestAddress(account uint32) (string, error) {
+	return testAddress, nil
+}
+
+func TestFeeForSize(t *testing.T) {
+	tests := []struct {
+		feeRate int64
+		size    int
+		fee     int64
+	}{
+		{feeRate: 10000, size: 1000, fee: 10000},
+		{feeRate: 10000, size: 250, fee: 2500},
+		{feeRate: 20000, size: cpfpTxSize, fee: 20000 * cpfpTxSize / 1000},
+		{feeRate: 10000, size: 0, fee: 0},
+	}
+	for _, test := range tests {
+		if fee := feeForSize(test.feeRate, test.size); fee != test.fee {
+			t.Errorf("feeForSize(%d, %d): expected %d, got %d", test.feeRate, test.size, test.fee, fee)
+		}
+	}
+}
+
+func TestWalletOutputIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		outputs []*txhelper.TxOutput
+		index   int
+	}{
+		{
+			name:    "no outputs",
+			outputs: nil,
+			index:   -1,
+		},
+		{
+			name: "no wallet outputs",
+			outputs: []*txhelper.TxOutput{
+				{Index: 0, Amount: 100, AccountNumber: -1},
+				{Index: 1, Amount: 200, AccountNumber: -1},
+			},
+			index: -1,
+		},
+		{
+			name: "largest wallet output",
+			outputs: []*txhelper.TxOutput{
+				{Index: 0, Amount: 300, AccountNumber: 0},
+				{Index: 1, Amount: 900, AccountNumber: -1},
+				{Index: 2, Amount: 500, AccountNumber: 1},
+				{Index: 3, Amount: 400, AccountNumber: 0},
+			},
+			index: 2,
+		},
+	}
+	for _, test := range tests {
+		tx := &Transaction{Transaction: &txhelper.Transaction{Outputs: test.outputs}}
+		if index := walletOutputIndex(tx); index != test.index {
+			t.Errorf("%s: expected index %d, got %d", test.name, test.index, index)
+		}
+	}
+}
+
+func TestNewCPFPTransaction(t *testing.T) {
+	parentTx := unminedTx(
+		&txhelper.TxOutput{Index: 1, Amount: 100000000, AccountNumber: 2},
+		&txhelper.TxOutput{Index: 2, Amount: 1000, AccountNumber: 0},
+	)
+
+	var addressAccount uint32
+	generateAddress := func(account uint32) (string, error) {
+		addressAccount = account
+		return testAddress, nil
+	}
+
+	feeRate := int64(20000)
+	childTx, err := NewCPFPTransaction(parentTx, feeRate, generateAddress)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if addressAccount != 2 {
+		t.Errorf("expected the change address to be generated for account 2, got account %d", addressAccount)
+	}
+
+	if len(childTx.TxIn) != 1 {
+		t.Fatalf("expected 1 input, got %d", len(childTx.TxIn))
+	}
+	prevOut := childTx.TxIn[0].PreviousOutPoint
+	if prevOut.Hash.String() != testTxHash || prevOut.Index != 1 || prevOut.Tree != wire.TxTreeRegular {
+		t.Errorf("expected the input to spend %s:1 in the regular tree, got %s:%d in tree %d",
+			testTxHash, prevOut.Hash, prevOut.Index, prevOut.Tree)
+	}
+	if childTx.TxIn[0].ValueIn != 100000000 {
+		t.Errorf("expected input value 100000000, got %d", childTx.TxIn[0].ValueIn)
+	}
+
+	if len(childTx.TxOut) != 1 {
+		t.Fatalf("expected 1 output, got %d", len(childTx.TxOut))
+	}
+	expectedPkScript, err := addressPkScript(testAddress)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(childTx.TxOut[0].PkScript) != string(expectedPkScript) {
+		t.Errorf("expected the output to pay to %s", testAddress)
+	}
+
+	// the child pays the parent's missing fee on top of its own fee at the new fee rate
+	expectedFee := feeForSize(feeRate, parentTx.Size+cpfpTxSize) - parentTx.Fee
+	if childFee := childTx.TxIn[0].ValueIn - childTx.TxOut[0].Value; childFee != expectedFee {
+		t.Errorf("expected child fee %d, got %d", expectedFee, childFee)
+	}
+}
+
+func TestNewCPFPTransactionPaysAtLeastItsOwnFee(t *testing.T) {
+	// the parent already pays almost enough for both transactions, the child must still pay for itself
+	parentTx := unminedTx(&txhelper.TxOutput{Index: 1, Amount: 100000000, AccountNumber: 0})
+	parentTx.Fee = 9000
+	parentTx.FeeRate = 9000
+
+	feeRate := int64(10000)
+	childTx, err := NewCPFPTransaction(parentTx, feeRate, generateTestAddress)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedFee := feeForSize(feeRate, cpfpTxSize)
+	if childFee := childTx.TxIn[0].ValueIn - childTx.TxOut[0].Value; childFee != expectedFee {
+		t.Errorf("expected child fee %d, got %d", expectedFee, childFee)
+	}
+}
+
+func TestNewCPFPTransactionErrors(t *testing.T) {
+	walletOutput := &txhelper.TxOutput{Index: 1, Amount: 100000000, AccountNumber: 0}
+
+	minedTx := unminedTx(walletOutput)
+	minedTx.BlockHeight = 100
+	if _, err := NewCPFPTransaction(minedTx, 20000, generateTestAddress); err != ErrTransactionMined {
+		t.Errorf("mined transaction: expected %v, got %v", ErrTransactionMined, err)
+	}
+
+	tests := []struct {
+		name            string
+		tx              *Transaction
+		feeRate         int64
+		generateAddress func(uint32) (string, error)
+	}{
+		{
+			name:            "fee rate not higher than current fee rate",
+			tx:              unminedTx(walletOutput),
+			feeRate:         10000,
+			generateAddress: generateTestAddress,
+		},
+		{
+			name:            "no wallet output",
+			tx:              unminedTx(),
+			feeRate:         20000,
+			generateAddress: generateTestAddress,
+		},
+		{
+			name:            "wallet output too small to pay the fee",
+			tx:              unminedTx(&txhelper.TxOutput{Index: 1, Amount: 5000, AccountNumber: 0}),
+			feeRate:         20000,
+			generateAddress: generateTestAddress,
+		},
+		{
+			name:    "address generation fails",
+			tx:      unminedTx(walletOutput),
+			feeRate: 20000,
+			generateAddress: func(uint32) (string, error) {
+				return "", errors.New("wallet is locked")
+			},
+		},
+	}
+	for _, test := range tests {
+		if childTx, err := NewCPFPTransaction(test.tx, test.feeRate, test.generateAddress); err == nil {
+			t.Errorf("%s: expected an error, got transaction %s", test.name, childTx.TxHash())
+		}
+	}
+}
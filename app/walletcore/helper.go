@@ -2,6 +2,7 @@ package walletcore
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"strconv"
 
@@ -16,6 +17,9 @@ const (
 	// standard decred min confirmations is 2, this should be used as default for wallet operations
 	DefaultRequiredConfirmations = 2
 
+	// account number of the wallet account that holds imported private keys and scripts
+	ImportedAccountNumber = math.MaxInt32
+
 	// default number of transactions to return per call to Wallet.TransactionHistory()
 	TransactionHistoryCountPerPage = 25
 
@@ -193,3 +197,25 @@ func TxDetails(tx *txhelper.Transaction, confirmations int32) *Transaction {
 		Status:        txhelper.TxStatus(confirmations),
 	}
 }
+
+// TxAccountName returns the name of the wallet account that a transaction input or output belongs to,
+// or "external" if the input or output does not belong to this wallet.
+func TxAccountName(accountNumber int32, accountName string) string {
+	if accountNumber == -1 {
+		return "external"
+	}
+	return accountName
+}
+
+// ValidateRescanHeight returns an error if `fromHeight` is not between the genesis block and `bestBlock`
+func ValidateRescanHeight(fromHeight int32, bestBlock uint32) error {
+	if fromHeight < 0 || fromHeight > int32(bestBlock) {
+		return fmt.Errorf("rescan height must be between 0 and the current block height %d", bestBlock)
+	}
+	return nil
+}
+
+// ChainReorgMessage describes a chain reorganisation for display to users
+func ChainReorgMessage(reorg *ChainReorg) string {
+	return fmt.Sprintf("Chain reorganisation at block %d changed %d transaction(s)", reorg.ForkHeight, len(reorg.AffectedTxHashes))
+}
@@ -66,12 +66,61 @@ type StakeInfo struct {
 	TotalSubsidy  string `json:"totalSubsidy"`
 }
 
+// Agenda describes a consensus rule change that ticket holders can vote on,
+// along with the wallet's current vote choice for the agenda.
+type Agenda struct {
+	ID          string          `json:"id"`
+	Description string          `json:"description"`
+	Choices     []*AgendaChoice `json:"choices"`
+	VoteChoice  string          `json:"vote_choice"`
+	StartTime   int64           `json:"start_time"`
+	ExpireTime  int64           `json:"expire_time"`
+}
+
+type AgendaChoice struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	IsAbstain   bool   `json:"is_abstain"`
+	IsNo        bool   `json:"is_no"`
+}
+
+// states of the connection to dcrwallet when the wallet is accessed through dcrwallet rpc
+const (
+	WalletRPCStateConnected    = "connected"
+	WalletRPCStateReconnecting = "reconnecting"
+)
+
 // ConnectionInfo holds connection information for the wallet
 type ConnectionInfo struct {
-	NetworkType    string `json:"networkType"`
-	PeersConnected int32  `json:"peersConnected"`
-	TotalBalance   string `json:"totalBalance"`
-	LatestBlock    uint32 `json:"latestBlock"`
+	NetworkType     string   `json:"networkType"`
+	PeersConnected  int32    `json:"peersConnected"`
+	PeerAddresses   []string `json:"peerAddresses"`   // addresses of connected peers, if known
+	ConfiguredPeers []string `json:"configuredPeers"` // spvconnect peers, not necessarily connected, set if connected peer addresses are not known
+	TotalBalance    string   `json:"totalBalance"`
+	LatestBlock     uint32   `json:"latestBlock"`
+	WalletRPCState  string   `json:"walletRPCState"` // empty if the wallet is not accessed through dcrwallet rpc
+}
+
+// ChainReorg describes a chain reorganisation in which blocks were detached from the main chain and replaced
+type ChainReorg struct {
+	ForkHeight       int32    `json:"forkHeight"` // blocks above this height were detached
+	DetachedBlocks   int      `json:"detachedBlocks"`
+	NewBestHeight    int32    `json:"newBestHeight"`
+	AffectedTxHashes []string `json:"affectedTxHashes"` // wallet transactions that were mined in the detached blocks
+}
+
+// TxIndexHealth reports how the mined transactions in the tx index database compare with the wallet's transactions
+type TxIndexHealth struct {
+	CheckedToHeight int32    `json:"checkedToHeight"` // mined transactions below this height were compared
+	IndexedCount    int      `json:"indexedCount"`
+	WalletCount     int      `json:"walletCount"`
+	MissingTxHashes []string `json:"missingTxHashes"` // wallet transactions that are not in the index
+	UnknownTxHashes []string `json:"unknownTxHashes"` // indexed transactions that the wallet does not have
+}
+
+// Healthy returns true if the index has exactly the same transactions as the wallet
+func (health *TxIndexHealth) Healthy() bool {
+	return len(health.MissingTxHashes) == 0 && len(health.UnknownTxHashes) == 0
 }
 
 type Transaction struct {
@@ -2,12 +2,16 @@ package walletcore
 
 import (
 	"context"
+	"errors"
 
 	"github.com/raedahgroup/dcrlibwallet"
 	"github.com/raedahgroup/dcrlibwallet/txhelper"
 	"github.com/raedahgroup/dcrlibwallet/txindex"
 )
 
+// ErrTransactionNotFound is returned by GetTransaction if the wallet has no transaction with the requested hash
+var ErrTransactionNotFound = errors.New("transaction not found")
+
 // Wallet defines key functions for performing operations on a decred wallet
 // These functions are implemented by the different mediums that provide access to a decred wallet
 type Wallet interface {
@@ -22,6 +26,12 @@ type Wallet interface {
 	// Returns account number for newly added account
 	NextAccount(accountName string, passphrase string) (uint32, error)
 
+	// RenameAccount changes the name of the account with the specified account number
+	RenameAccount(accountNumber uint32, newName string) error
+
+	// AccountExtendedPubKey returns the extended public key (xpub) for the account with the specified account number
+	AccountExtendedPubKey(accountNumber uint32) (string, error)
+
 	// AccountNumber looks up and returns an account number by the account's unique name
 	AccountNumber(accountName string) (uint32, error)
 
@@ -42,6 +52,14 @@ type Wallet interface {
 	// regardless of whether there was a previously generated address that has not been used
 	GenerateNewAddress(account uint32) (string, error)
 
+	// ImportPrivateKey imports a WIF-encoded private key into the wallet's imported account.
+	// If `rescan` is true, the blockchain is rescanned from `rescanFromHeight` to find transactions involving the key.
+	ImportPrivateKey(ctx context.Context, privateKeyWif, passphrase string, rescan bool, rescanFromHeight int32) error
+
+	// ImportScript imports a hex-encoded redeem script (e.g. a multisig or stake pool script) into the wallet's imported account.
+	// If `rescan` is true, the blockchain is rescanned from `rescanFromHeight` to find transactions involving the script.
+	ImportScript(ctx context.Context, scriptHex, passphrase string, rescan bool, rescanFromHeight int32) error
+
 	// UnspentOutputs lists all unspent outputs in the specified account that sum up to `targetAmount`
 	// If `targetAmount` is 0, all unspent outputs in account are returned
 	UnspentOutputs(account uint32, targetAmount int64, requiredConfirmations int32) ([]*UnspentOutput, error)
@@ -58,6 +76,26 @@ type Wallet interface {
 	// Returns the transaction hash as string if successful
 	SendFromUTXOs(sourceAccount uint32, requiredConfirmations int32, utxoKeys []string, txDestinations []txhelper.TransactionDestination, changeDestinations []txhelper.TransactionDestination, passphrase string) (string, error)
 
+	// PublishTransaction broadcasts a fully signed, serialized transaction to the network.
+	// Returns the transaction hash as string if successful.
+	PublishTransaction(ctx context.Context, signedTx []byte) (string, error)
+
+	// UnminedTransactions returns the wallet's transactions that have not been included in a block yet.
+	UnminedTransactions(ctx context.Context) ([]*Transaction, error)
+
+	// RebroadcastUnminedTransactions publishes all of the wallet's unmined transactions to connected peers again.
+	RebroadcastUnminedTransactions(ctx context.Context) error
+
+	// AbandonTransaction removes an unmined transaction from the wallet so that its inputs can be spent by another transaction.
+	// Returns an error if the transaction is already mined.
+	AbandonTransaction(ctx context.Context, transactionHash string) error
+
+	// BumpTransactionFee increases the fee rate of an unmined transaction to `feeRate` atoms/kB.
+	// A child transaction that spends the transaction's change output is published, paying for both transactions (CPFP).
+	// Decred does not relay replacements of unmined transactions, so the transaction itself is never replaced.
+	// Returns the hash of the published child transaction.
+	BumpTransactionFee(ctx context.Context, transactionHash string, feeRate int64, passphrase string) (string, error)
+
 	// TransactionCount returns the number of transactions in the tx index database.
 	// If `filter` is set to `nil`, all transactions are counted.
 	// Otherwise, only transactions matching the provided filter are counted.
@@ -78,7 +116,7 @@ type Wallet interface {
 	TransactionHistory(offset, count int32, filter *txindex.ReadFilter) ([]*Transaction, error)
 
 	// GetTransaction returns information about the transaction with the given hash.
-	// An error is returned if the no transaction with the given hash is found.
+	// ErrTransactionNotFound is returned if the wallet has no transaction with the given hash.
 	GetTransaction(transactionHash string) (*Transaction, error)
 
 	// StakeInfo returns information about wallet stakes, tickets and their statuses.
@@ -90,6 +128,13 @@ type Wallet interface {
 	// TicketPrice returns the current ticket price
 	TicketPrice(ctx context.Context) (ticketPrice int64, err error)
 
+	// VoteChoices returns the consensus agendas for the wallet's current vote version
+	// along with the choice the wallet's tickets will vote for on each agenda.
+	VoteChoices(ctx context.Context) ([]*Agenda, error)
+
+	// SetVoteChoice sets the choice the wallet's tickets will vote for on the agenda with the specified id.
+	SetVoteChoice(ctx context.Context, agendaID, choiceID string) error
+
 	// ChangePrivatePassphrase changes the private passphrase from the oldPass to the provided newPass
 	ChangePrivatePassphrase(ctx context.Context, oldPass, newPass string) error
 
@@ -3,13 +3,21 @@ package dcrlibwallet
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/decred/dcrwallet/netparams"
 	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
 	"github.com/raedahgroup/dcrlibwallet/utils"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/logging"
+	"github.com/raedahgroup/godcr/app/walletmediums/walletlog"
 )
 
+// logMedium identifies this wallet medium in log entries
+const logMedium = "dcrlibwallet"
+
 // DcrWalletLib implements `WalletMiddleware` using `dcrlibwallet.LibWallet` as medium for connecting to a decred wallet
 // Functions relating to operations that can be performed on a wallet are defined in `walletfunctions.go`
 // Other wallet-related functions are defined in `walletloader.go`
@@ -17,10 +25,23 @@ type DcrWalletLib struct {
 	WalletDbDir string
 	walletLib   *dcrlibwallet.LibWallet
 	activeNet   *netparams.Params
+	syncOptions config.SyncOptions
+
+	syncMu       sync.Mutex
+	syncCtx      context.Context
+	syncListener *defaultsynclistener.DefaultSyncListener
+	cancelSync   context.CancelFunc
+	syncStatus   defaultsynclistener.SyncStatus
+	// syncStopped is closed when the sync canceled by RestartSync reports that it stopped
+	syncStopped chan struct{}
+
+	blockListener     *blockNotificationListener
+	blockListenerOnce sync.Once
 }
 
-// Connect opens connection to the wallet database via dcrlibwallet and returns an instance of DcrWalletLib
-func Connect(ctx context.Context, walletDbDir, networkType string) (*DcrWalletLib, error) {
+// Connect opens connection to the wallet database via dcrlibwallet and returns an instance of DcrWalletLib.
+// `syncOptions` determine whether the blockchain is synced with spv peers or through a dcrd node.
+func Connect(ctx context.Context, walletDbDir, networkType string, syncOptions config.SyncOptions) (*DcrWalletLib, error) {
 	activeNet := utils.NetParams(networkType)
 	if activeNet == nil {
 		return nil, fmt.Errorf("unsupported wallet: %s", networkType)
@@ -37,11 +58,22 @@ func Connect(ctx context.Context, walletDbDir, networkType string) (*DcrWalletLi
 		return nil, err
 	}
 
-	return &DcrWalletLib{
+	lib := &DcrWalletLib{
 		WalletDbDir: walletDbDir,
 		walletLib:   lw,
 		activeNet:   activeNet,
-	}, nil
+		syncOptions: syncOptions,
+	}
+	lib.blockListener = &blockNotificationListener{lib: lib}
+
+	if lw.WalletOpened() {
+		walletlog.Log.Info("Wallet opened", logging.Fields{
+			"medium":     logMedium,
+			"network":    networkType,
+			"wallet_dir": walletDbDir,
+		})
+	}
+	return lib, nil
 }
 
 // This method may stall if the wallet database is in use by some other process,
@@ -0,0 +1,84 @@
+package dcrlibwallet
+
+import (
+	"sync"
+
+	"github.com/raedahgroup/godcr/app/logging"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/app/walletmediums/walletlog"
+)
+
+// blockNotificationListener implements `dcrlibwallet.TransactionListener` to detect chain reorganisations.
+// dcrlibwallet only reports attached blocks, so a reorganisation is detected when a block is attached
+// at or below the height of the last attached block, which means the blocks above the new block's parent were detached.
+type blockNotificationListener struct {
+	lib *DcrWalletLib
+
+	mu                 sync.Mutex
+	lastAttachedHeight int32
+	chainReorgListener func(reorg *walletcore.ChainReorg)
+}
+
+func (listener *blockNotificationListener) OnTransaction(transaction string) {}
+
+func (listener *blockNotificationListener) OnTransactionConfirmed(hash string, height int32) {}
+
+func (listener *blockNotificationListener) OnBlockAttached(height int32, timestamp int64) {
+	listener.mu.Lock()
+	lastAttachedHeight := listener.lastAttachedHeight
+	listener.lastAttachedHeight = height
+	onChainReorg := listener.chainReorgListener
+	listener.mu.Unlock()
+
+	if height > lastAttachedHeight || lastAttachedHeight == 0 || onChainReorg == nil {
+		return
+	}
+
+	reorg := &walletcore.ChainReorg{
+		ForkHeight:     height - 1,
+		DetachedBlocks: int(lastAttachedHeight - height + 1),
+		NewBestHeight:  height,
+	}
+	reorg.AffectedTxHashes = listener.lib.txHashesMinedAbove(reorg.ForkHeight)
+	onChainReorg(reorg)
+}
+
+// SetChainReorgListener sets the function that is called when blocks attached by dcrlibwallet show that a chain reorganisation occurred.
+// dcrlibwallet updates transactions in its tx index as the replacing blocks are attached,
+// so the reported transactions are those recorded above the fork height when the reorganisation was detected.
+func (lib *DcrWalletLib) SetChainReorgListener(onChainReorg func(reorg *walletcore.ChainReorg)) {
+	lib.blockListener.mu.Lock()
+	lib.blockListener.chainReorgListener = onChainReorg
+	lib.blockListener.mu.Unlock()
+}
+
+// listenForBlockNotifications registers lib.blockListener with dcrlibwallet once the wallet is open
+func (lib *DcrWalletLib) listenForBlockNotifications() {
+	lib.blockListenerOnce.Do(func() {
+		lib.blockListener.lastAttachedHeight = lib.walletLib.GetBestBlock()
+		lib.walletLib.TransactionNotification(lib.blockListener)
+	})
+}
+
+// txHashesMinedAbove returns the hashes of the wallet's transactions recorded as mined above `height`
+func (lib *DcrWalletLib) txHashesMinedAbove(height int32) []string {
+	txCount, err := lib.walletLib.TxCount(nil)
+	if err != nil {
+		walletlog.Log.Error("Error counting txs after chain reorganisation", logging.Fields{"medium": logMedium, "error": err.Error()})
+		return nil
+	}
+
+	txs, err := lib.walletLib.GetTransactionsRaw(0, int32(txCount), nil)
+	if err != nil {
+		walletlog.Log.Error("Error reading txs after chain reorganisation", logging.Fields{"medium": logMedium, "error": err.Error()})
+		return nil
+	}
+
+	var hashes []string
+	for _, tx := range txs {
+		if tx.BlockHeight > height {
+			hashes = append(hashes, tx.Hash)
+		}
+	}
+	return hashes
+}
@@ -2,12 +2,14 @@ package dcrlibwallet
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrwallet/wallet"
 	"github.com/raedahgroup/dcrlibwallet"
 	"github.com/raedahgroup/dcrlibwallet/addresshelper"
 	"github.com/raedahgroup/dcrlibwallet/txhelper"
@@ -15,6 +17,13 @@ import (
 	"github.com/raedahgroup/godcr/app/walletcore"
 )
 
+// txNotFoundError is the message of the error returned by the storm db behind dcrlibwallet's tx index
+// when it has no transaction with the requested hash. The error is not exported by dcrlibwallet.
+const txNotFoundError = "not found"
+
+var errAbandonNotSupported = errors.New("abandoning transactions is not supported by dcrlibwallet, " +
+	"connect to dcrwallet over rpc (set walletrpcserver in the config file) to abandon transactions")
+
 func (lib *DcrWalletLib) AccountBalance(accountNumber uint32, requiredConfirmations int32) (*walletcore.Balance, error) {
 	balance, err := lib.walletLib.GetAccountBalance(accountNumber, requiredConfirmations)
 	if err != nil {
@@ -70,6 +79,14 @@ func (lib *DcrWalletLib) NextAccount(accountName string, passphrase string) (uin
 	return lib.walletLib.NextAccountRaw(accountName, []byte(passphrase))
 }
 
+func (lib *DcrWalletLib) RenameAccount(accountNumber uint32, newName string) error {
+	return lib.walletLib.RenameAccount(int32(accountNumber), newName)
+}
+
+func (lib *DcrWalletLib) AccountExtendedPubKey(accountNumber uint32) (string, error) {
+	return lib.walletLib.GetAccountExtendedPubKey(accountNumber)
+}
+
 func (lib *DcrWalletLib) AccountNumber(accountName string) (uint32, error) {
 	return lib.walletLib.AccountNumber(accountName)
 }
@@ -94,6 +111,52 @@ func (lib *DcrWalletLib) GenerateNewAddress(account uint32) (string, error) {
 	return lib.walletLib.NextAddress(int32(account))
 }
 
+func (lib *DcrWalletLib) ImportPrivateKey(ctx context.Context, privateKeyWif, passphrase string, rescan bool, rescanFromHeight int32) error {
+	if rescan && rescanFromHeight > 0 {
+		return errRescanFromHeightNotSupported
+	}
+
+	err := lib.walletLib.ImportPrivateKey(privateKeyWif, []byte(passphrase))
+	if err != nil {
+		return fmt.Errorf("error importing private key: %s", err.Error())
+	}
+
+	if rescan {
+		return lib.rescanForImports()
+	}
+	return nil
+}
+
+func (lib *DcrWalletLib) ImportScript(ctx context.Context, scriptHex, passphrase string, rescan bool, rescanFromHeight int32) error {
+	if rescan && rescanFromHeight > 0 {
+		return errRescanFromHeightNotSupported
+	}
+
+	script, err := hex.DecodeString(scriptHex)
+	if err != nil {
+		return fmt.Errorf("invalid script hex: %s", err.Error())
+	}
+
+	err = lib.walletLib.ImportScript(script, []byte(passphrase))
+	if err != nil {
+		return fmt.Errorf("error importing script: %s", err.Error())
+	}
+
+	if rescan {
+		return lib.rescanForImports()
+	}
+	return nil
+}
+
+// rescanForImports rescans the whole blockchain to find transactions involving newly imported keys and scripts.
+// dcrlibwallet does not support rescanning from a specific height, imports that request one are rejected before importing.
+func (lib *DcrWalletLib) rescanForImports() error {
+	if err := lib.walletLib.RescanBlocks(); err != nil {
+		return fmt.Errorf("error rescanning blockchain: %s", err.Error())
+	}
+	return nil
+}
+
 func (lib *DcrWalletLib) UnspentOutputs(account uint32, targetAmount int64, requiredConfirmations int32) ([]*walletcore.UnspentOutput, error) {
 	utxos, err := lib.walletLib.UnspentOutputs(account, requiredConfirmations, targetAmount)
 	if err != nil {
@@ -155,6 +218,77 @@ func (lib *DcrWalletLib) SendFromUTXOs(sourceAccount uint32, requiredConfirmatio
 		changeDestinations, []byte(passphrase))
 }
 
+func (lib *DcrWalletLib) PublishTransaction(ctx context.Context, signedTx []byte) (string, error) {
+	txHash, err := lib.walletLib.PublishTransaction(signedTx)
+	if err != nil {
+		return "", fmt.Errorf("error publishing transaction: %s", err.Error())
+	}
+
+	transactionHash, err := chainhash.NewHash(txHash)
+	if err != nil {
+		return "", fmt.Errorf("error parsing successful transaction hash: %s", err.Error())
+	}
+
+	return transactionHash.String(), nil
+}
+
+// UnminedTransactions reads the wallet's transactions from the tx index and returns those that are not in a block.
+func (lib *DcrWalletLib) UnminedTransactions(ctx context.Context) ([]*walletcore.Transaction, error) {
+	txCount, err := lib.walletLib.TxCount(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := lib.walletLib.GetTransactionsRaw(0, int32(txCount), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var unminedTxs []*walletcore.Transaction
+	for _, tx := range txs {
+		if tx.BlockHeight == -1 {
+			unminedTxs = append(unminedTxs, walletcore.TxDetails(tx, 0))
+		}
+	}
+	return unminedTxs, nil
+}
+
+func (lib *DcrWalletLib) RebroadcastUnminedTransactions(ctx context.Context) error {
+	if err := lib.walletLib.PublishUnminedTransactions(); err != nil {
+		return fmt.Errorf("error rebroadcasting unmined transactions: %s", err.Error())
+	}
+	return nil
+}
+
+// AbandonTransaction is not supported by dcrlibwallet, which does not expose a way to remove transactions from the wallet.
+func (lib *DcrWalletLib) AbandonTransaction(ctx context.Context, transactionHash string) error {
+	return errAbandonNotSupported
+}
+
+func (lib *DcrWalletLib) BumpTransactionFee(ctx context.Context, transactionHash string, feeRate int64, passphrase string) (string, error) {
+	tx, err := lib.GetTransaction(transactionHash)
+	if err != nil {
+		return "", err
+	}
+
+	childTx, err := walletcore.NewCPFPTransaction(tx, feeRate, lib.GenerateNewAddress)
+	if err != nil {
+		return "", err
+	}
+
+	serializedTx, err := childTx.Bytes()
+	if err != nil {
+		return "", fmt.Errorf("error serializing transaction: %s", err.Error())
+	}
+
+	signedTx, err := lib.walletLib.SignTransaction(serializedTx, []byte(passphrase))
+	if err != nil {
+		return "", fmt.Errorf("error signing transaction: %s", err.Error())
+	}
+
+	return lib.PublishTransaction(ctx, signedTx)
+}
+
 func (lib *DcrWalletLib) TransactionCount(filter *txindex.ReadFilter) (int, error) {
 	return lib.walletLib.TxCount(filter)
 }
@@ -180,7 +314,9 @@ func (lib *DcrWalletLib) GetTransaction(transactionHash string) (*walletcore.Tra
 	}
 
 	tx, err := lib.walletLib.GetTransactionRaw(hash[:])
-	if err != nil {
+	if err != nil && err.Error() == txNotFoundError {
+		return nil, walletcore.ErrTransactionNotFound
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -244,6 +380,62 @@ func (lib *DcrWalletLib) PurchaseTicket(ctx context.Context, request dcrlibwalle
 	return tickets, nil
 }
 
+func (lib *DcrWalletLib) VoteChoices(ctx context.Context) ([]*walletcore.Agenda, error) {
+	agendaChoices, err := lib.walletLib.AgendaChoices()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching vote choices: %s", err.Error())
+	}
+
+	voteChoices := make(map[string]string, len(agendaChoices))
+	for _, choice := range agendaChoices {
+		voteChoices[choice.AgendaID] = choice.ChoiceID
+	}
+
+	// agendas that can be voted on are the deployments for the latest vote version of the active network
+	var voteVersion uint32
+	for version := range lib.activeNet.Deployments {
+		if version > voteVersion {
+			voteVersion = version
+		}
+	}
+
+	deployments := lib.activeNet.Deployments[voteVersion]
+	agendas := make([]*walletcore.Agenda, len(deployments))
+	for i, deployment := range deployments {
+		choices := make([]*walletcore.AgendaChoice, len(deployment.Vote.Choices))
+		for j, choice := range deployment.Vote.Choices {
+			choices[j] = &walletcore.AgendaChoice{
+				ID:          choice.Id,
+				Description: choice.Description,
+				IsAbstain:   choice.IsAbstain,
+				IsNo:        choice.IsNo,
+			}
+		}
+
+		agendas[i] = &walletcore.Agenda{
+			ID:          deployment.Vote.Id,
+			Description: deployment.Vote.Description,
+			Choices:     choices,
+			VoteChoice:  voteChoices[deployment.Vote.Id],
+			StartTime:   int64(deployment.StartTime),
+			ExpireTime:  int64(deployment.ExpireTime),
+		}
+	}
+
+	return agendas, nil
+}
+
+func (lib *DcrWalletLib) SetVoteChoice(ctx context.Context, agendaID, choiceID string) error {
+	err := lib.walletLib.SetAgendaChoices(wallet.AgendaChoice{
+		AgendaID: agendaID,
+		ChoiceID: choiceID,
+	})
+	if err != nil {
+		return fmt.Errorf("error setting vote choice: %s", err.Error())
+	}
+	return nil
+}
+
 func (lib *DcrWalletLib) ChangePrivatePassphrase(_ context.Context, oldPass, newPass string) error {
 	if oldPass == "" || newPass == "" {
 		return errors.New("Passphrase cannot be empty")
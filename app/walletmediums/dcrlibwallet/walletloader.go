@@ -1,18 +1,34 @@
 package dcrlibwallet
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/raedahgroup/dcrlibwallet"
 	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
 	"github.com/raedahgroup/dcrlibwallet/utils"
+	"github.com/raedahgroup/godcr/app/logging"
 	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/app/walletmediums/walletlog"
 )
 
 var numberOfPeers int32
 
+// syncStopTimeout is how long RestartSync waits for a canceled sync to report that it stopped
+const syncStopTimeout = 30 * time.Second
+
+var (
+	errSyncNotStarted               = errors.New("blockchain sync has not been started")
+	errSyncCanceled                 = errors.New("blockchain sync canceled")
+	errTxIndexNotSupported          = errors.New("dcrlibwallet maintains its own tx index, checking and rebuilding it requires dcrwallet rpc")
+	errRescanFromHeightNotSupported = errors.New("dcrlibwallet can only rescan the whole blockchain, rescanning from a block height requires dcrwallet rpc")
+)
+
 func (lib *DcrWalletLib) GenerateNewWalletSeed() (string, error) {
 	return utils.GenerateSeed()
 }
@@ -29,12 +45,16 @@ func (lib *DcrWalletLib) IsWalletOpen() bool {
 	return lib.walletLib.WalletOpened()
 }
 
-func (lib *DcrWalletLib) SyncBlockChain(showLog bool, syncProgressUpdated func(*defaultsynclistener.ProgressReport)) {
-	// create wrapper around syncProgressUpdated to store updated peer count before calling main syncInfoUpdated fn
+func (lib *DcrWalletLib) SyncBlockChain(ctx context.Context, showLog bool, syncProgressUpdated func(*defaultsynclistener.ProgressReport)) {
+	logSyncStatus := walletlog.SyncStatusLogger(logMedium, lib.syncOptions)
+
+	// create wrapper around syncProgressUpdated to store updated peer count and log sync status changes before calling main syncInfoUpdated fn
 	syncInfoUpdatedWrapper := func(progressReport *defaultsynclistener.ProgressReport, op defaultsynclistener.SyncOp) {
 		if op == defaultsynclistener.PeersCountUpdate {
 			numberOfPeers = progressReport.Read().ConnectedPeers
 		}
+		lib.setSyncStatus(progressReport.Read().Status)
+		logSyncStatus(progressReport)
 		syncProgressUpdated(progressReport)
 	}
 
@@ -43,16 +63,156 @@ func (lib *DcrWalletLib) SyncBlockChain(showLog bool, syncProgressUpdated func(*
 		lib.walletLib.GetBestBlock, lib.walletLib.GetBestBlockTimeStamp, syncInfoUpdatedWrapper)
 	lib.walletLib.AddSyncProgressListener(syncListener)
 
-	err := lib.walletLib.SpvSync("")
+	lib.syncMu.Lock()
+	lib.syncCtx = ctx
+	lib.syncListener = syncListener
+	lib.syncMu.Unlock()
+
+	lib.listenForBlockNotifications()
+	lib.startSync()
+}
+
+// startSync syncs the blockchain using the context and listener set by the last SyncBlockChain call.
+// dcrlibwallet runs the sync in the background and is told to stop syncing if that context is canceled.
+func (lib *DcrWalletLib) startSync() {
+	lib.syncMu.Lock()
+	parentCtx, syncListener := lib.syncCtx, lib.syncListener
+	syncCtx, cancelSync := context.WithCancel(parentCtx)
+	lib.cancelSync = cancelSync
+	lib.syncStatus = defaultsynclistener.SyncStatusInProgress
+	lib.syncMu.Unlock()
+
+	walletlog.LogSyncStarted(logMedium, lib.syncOptions)
+
+	go func() {
+		<-syncCtx.Done()
+		// sync stopped via CancelSync or RestartSync is already canceled in dcrlibwallet
+		if parentCtx.Err() != nil {
+			lib.walletLib.CancelSync()
+		}
+	}()
+
+	if lib.syncOptions.UseDcrdRPC() {
+		lib.rpcSync(syncListener)
+		return
+	}
+
+	// dcrlibwallet only connects to the specified peers if any, separated by ;
+	err := lib.walletLib.SpvSync(strings.Join(lib.syncOptions.SPVConnect, ";"))
 	if err != nil {
 		syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
 	}
 }
 
-func (lib *DcrWalletLib) RescanBlockChain() error {
+func (lib *DcrWalletLib) CancelSync() {
+	if lib.stopSync() {
+		lib.syncMu.Lock()
+		syncListener := lib.syncListener
+		lib.syncMu.Unlock()
+
+		syncListener.OnSyncError(dcrlibwallet.ErrorCodeContextCanceled, errSyncCanceled)
+	}
+}
+
+// RestartSync cancels the ongoing sync and starts syncing again once dcrlibwallet reports that the canceled sync stopped,
+// so that the new sync does not run alongside the old one.
+// The new sync is started anyway if the old sync does not report stopping within syncStopTimeout.
+func (lib *DcrWalletLib) RestartSync() error {
+	lib.syncMu.Lock()
+	if lib.syncCtx == nil {
+		lib.syncMu.Unlock()
+		return errSyncNotStarted
+	}
+	syncStopped := make(chan struct{})
+	if lib.syncStatus == defaultsynclistener.SyncStatusError {
+		// the last sync already stopped with an error, there is nothing to wait for
+		close(syncStopped)
+	} else {
+		lib.syncStopped = syncStopped
+	}
+	lib.syncMu.Unlock()
+
+	if lib.stopSync() {
+		select {
+		case <-syncStopped:
+		case <-time.After(syncStopTimeout):
+			walletlog.Log.Warn("Canceled sync did not report stopping, restarting sync anyway",
+				logging.Fields{"medium": logMedium, "timeout": syncStopTimeout.String()})
+		}
+	}
+
+	lib.startSync()
+	return nil
+}
+
+// setSyncStatus records the last sync status reported by dcrlibwallet.
+// dcrlibwallet reports a canceled sync as a sync error, which signals RestartSync that the canceled sync stopped.
+func (lib *DcrWalletLib) setSyncStatus(status defaultsynclistener.SyncStatus) {
+	lib.syncMu.Lock()
+	defer lib.syncMu.Unlock()
+
+	lib.syncStatus = status
+	if status == defaultsynclistener.SyncStatusError && lib.syncStopped != nil {
+		close(lib.syncStopped)
+		lib.syncStopped = nil
+	}
+}
+
+// stopSync cancels the ongoing sync in dcrlibwallet, returns false if there is no ongoing sync
+func (lib *DcrWalletLib) stopSync() bool {
+	lib.syncMu.Lock()
+	cancelSync := lib.cancelSync
+	lib.cancelSync = nil
+	lib.syncMu.Unlock()
+
+	if cancelSync == nil {
+		return false
+	}
+
+	cancelSync()
+	// syncMu is not held here, dcrlibwallet may report the cancellation to the sync listener before CancelSync returns
+	lib.walletLib.CancelSync()
+	return true
+}
+
+// rpcSync syncs the blockchain through the dcrd node set in config instead of spv peers.
+// The dcrd node is reported as the only connected peer.
+func (lib *DcrWalletLib) rpcSync(syncListener *defaultsynclistener.DefaultSyncListener) {
+	cert, err := lib.syncOptions.DcrdRPCCertificate()
+	if err != nil {
+		syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
+		return
+	}
+
+	err = lib.walletLib.RpcSync(lib.syncOptions.DcrdRPCServer, lib.syncOptions.DcrdRPCUser, lib.syncOptions.DcrdRPCPassword, cert)
+	if err != nil {
+		syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
+		return
+	}
+	syncListener.OnPeerConnected(1)
+}
+
+// RescanBlockChain rescans the blockchain in the background, reporting progress to the sync listener.
+// dcrlibwallet can only rescan from the genesis block, so a non-zero `fromHeight` is validated
+// and then honoured by a full rescan, which also covers every block from `fromHeight` onwards.
+func (lib *DcrWalletLib) RescanBlockChain(fromHeight int32) error {
+	if err := walletcore.ValidateRescanHeight(fromHeight, uint32(lib.walletLib.GetBestBlock())); err != nil {
+		return err
+	}
 	return lib.walletLib.RescanBlocks()
 }
 
+// CheckTxIndex is not supported. dcrlibwallet only exposes transactions read from its own tx index,
+// so there is no separate list of the wallet's transactions to compare the index with.
+func (lib *DcrWalletLib) CheckTxIndex(ctx context.Context) (*walletcore.TxIndexHealth, error) {
+	return nil, errTxIndexNotSupported
+}
+
+// RebuildTxIndex is not supported, dcrlibwallet does not expose its tx index for rebuilding
+func (lib *DcrWalletLib) RebuildTxIndex(ctx context.Context, indexProgress func(indexedThrough, bestBlock int32)) error {
+	return errTxIndexNotSupported
+}
+
 func (lib *DcrWalletLib) WalletConnectionInfo() (info walletcore.ConnectionInfo, err error) {
 	accounts, loadAccountErr := lib.AccountsOverview(walletcore.DefaultRequiredConfirmations)
 	if loadAccountErr != nil {
@@ -76,10 +236,25 @@ func (lib *DcrWalletLib) WalletConnectionInfo() (info walletcore.ConnectionInfo,
 	info.LatestBlock = bestBlock
 	info.NetworkType = lib.NetType()
 	info.PeersConnected = numberOfPeers
+	info.PeerAddresses = lib.connectedPeerAddresses()
+	if len(info.PeerAddresses) == 0 && !lib.syncOptions.UseDcrdRPC() {
+		info.ConfiguredPeers = lib.syncOptions.SPVConnect
+	}
 
 	return
 }
 
+// connectedPeerAddresses returns the addresses of connected peers where they are known.
+// dcrlibwallet only reports the number of connected peers, so the address is only known when syncing through dcrd.
+// Peers set with spvconnect are returned as configured peers by WalletConnectionInfo instead,
+// since the count does not show which of them are connected.
+func (lib *DcrWalletLib) connectedPeerAddresses() []string {
+	if numberOfPeers > 0 && lib.syncOptions.UseDcrdRPC() {
+		return []string{lib.syncOptions.DcrdRPCServer}
+	}
+	return nil
+}
+
 func (lib *DcrWalletLib) BestBlock() (uint32, error) {
 	return uint32(lib.walletLib.GetBestBlock()), nil
 }
@@ -0,0 +1,48 @@
+package dcrwalletrpc
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil"
+)
+
+// clientCertValidity is how long generated client certificates remain valid
+const clientCertValidity = 10 * 365 * 24 * time.Hour
+
+// GenerateClientCertificate generates a self-signed certificate and key that godcr can present to dcrwallet's gRPC server.
+// The certificate is its own certificate authority, so dcrwallet accepts it once it is set as dcrwallet's clientcafile.
+// Existing files are not overwritten unless `overwrite` is true.
+func GenerateClientCertificate(certFile, keyFile string, overwrite bool) error {
+	if !overwrite {
+		for _, file := range []string{certFile, keyFile} {
+			if _, err := os.Stat(file); err == nil {
+				return fmt.Errorf("%s already exists", file)
+			}
+		}
+	}
+
+	validUntil := time.Now().Add(clientCertValidity)
+	cert, key, err := dcrutil.NewTLSCertPair("godcr client cert", validUntil, nil)
+	if err != nil {
+		return fmt.Errorf("error generating client certificate: %s", err.Error())
+	}
+
+	for _, file := range []string{certFile, keyFile} {
+		if err = os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+			return err
+		}
+	}
+
+	if err = ioutil.WriteFile(certFile, cert, 0644); err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		os.Remove(certFile)
+		return err
+	}
+	return nil
+}
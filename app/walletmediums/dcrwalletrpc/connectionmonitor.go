@@ -0,0 +1,148 @@
+package dcrwalletrpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/decred/dcrwallet/rpc/walletrpc"
+	"github.com/raedahgroup/godcr/app/logging"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/app/walletmediums/walletlog"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// how often to ping dcrwallet to check that the rpc connection is still alive
+	connectionCheckInterval = 10 * time.Second
+	pingTimeout             = 5 * time.Second
+
+	// delays between reconnect attempts start at reconnectMinDelay and double after every failed attempt up to reconnectMaxDelay
+	reconnectMinDelay = 1 * time.Second
+	reconnectMaxDelay = 1 * time.Minute
+)
+
+// streams that are restarted by monitorConnection if they end because the connection to dcrwallet dropped
+const (
+	txNotificationStreamName = "tx notification"
+	syncStreamName           = "sync"
+)
+
+// monitorConnection pings dcrwallet periodically until ctx is canceled.
+// If a ping fails, the connection is marked as reconnecting and dcrwallet is pinged again with exponential backoff
+// while grpc re-establishes the underlying connection.
+// Streams that end because the connection dropped are reported on c.endedStreams by the goroutines reading them.
+// Once dcrwallet responds to a ping after a stream ended, the wallet is re-opened and only the ended streams are started again.
+func (c *WalletRPCClient) monitorConnection(ctx context.Context) {
+	delay := connectionCheckInterval
+	endedStreams := make(map[string]bool)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case stream := <-c.endedStreams:
+			endedStreams[stream] = true
+			if delay > reconnectMinDelay {
+				// check the connection soon, so the stream is restarted once dcrwallet responds
+				delay = reconnectMinDelay
+			}
+			continue
+		case <-time.After(delay):
+		}
+
+		if err := c.ping(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			if c.setConnectionState(walletcore.WalletRPCStateReconnecting) {
+				walletlog.Log.Warn("Lost connection to dcrwallet", logging.Fields{"server": c.serverAddress, "error": err.Error()})
+				delay = reconnectMinDelay
+			} else {
+				delay *= 2
+				if delay > reconnectMaxDelay {
+					delay = reconnectMaxDelay
+				}
+			}
+			continue
+		}
+
+		delay = connectionCheckInterval
+		if c.setConnectionState(walletcore.WalletRPCStateConnected) {
+			walletlog.Log.Info("Reconnected to dcrwallet", logging.Fields{"server": c.serverAddress})
+		}
+		if len(endedStreams) > 0 {
+			c.restartEndedStreams(ctx, endedStreams)
+		}
+	}
+}
+
+// streamEnded reports a stream that ended with `err` to monitorConnection, so it is restarted once dcrwallet is reachable.
+// Streams that ended for any other reason than the connection to dcrwallet dropping are not restarted.
+func (c *WalletRPCClient) streamEnded(ctx context.Context, stream string, err error) {
+	if !isRpcErrorCode(err, codes.Unavailable) {
+		return
+	}
+
+	select {
+	case c.endedStreams <- stream:
+	case <-ctx.Done():
+	}
+}
+
+func (c *WalletRPCClient) ping(ctx context.Context) error {
+	pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	_, err := c.walletService.Ping(pingCtx, &walletrpc.PingRequest{})
+	return err
+}
+
+// setConnectionState updates the rpc connection state and returns true if the state changed
+func (c *WalletRPCClient) setConnectionState(state string) bool {
+	c.connectionStateMu.Lock()
+	defer c.connectionStateMu.Unlock()
+
+	if c.connectionState == state {
+		return false
+	}
+	c.connectionState = state
+	return true
+}
+
+func (c *WalletRPCClient) connectionStateValue() string {
+	c.connectionStateMu.Lock()
+	defer c.connectionStateMu.Unlock()
+	return c.connectionState
+}
+
+// restartEndedStreams re-opens the wallet in case dcrwallet was restarted and starts the streams in `endedStreams` again.
+// Streams that are restarted are removed from `endedStreams`, others are retried after the next successful ping.
+func (c *WalletRPCClient) restartEndedStreams(ctx context.Context, endedStreams map[string]bool) {
+	if !c.walletOpen {
+		return
+	}
+
+	_, err := c.walletLoader.OpenWallet(ctx, &walletrpc.OpenWalletRequest{})
+	if err != nil && !isRpcErrorCode(err, codes.AlreadyExists) {
+		walletlog.Log.Error("Error re-opening wallet after reconnecting to dcrwallet", logging.Fields{"server": c.serverAddress, "error": err.Error()})
+		return
+	}
+
+	if endedStreams[txNotificationStreamName] {
+		if err = c.ListenForTxNotification(ctx); err != nil {
+			walletlog.Log.Error("Error restarting stream after reconnecting to dcrwallet",
+				logging.Fields{"server": c.serverAddress, "stream": txNotificationStreamName, "error": err.Error()})
+		} else {
+			delete(endedStreams, txNotificationStreamName)
+		}
+	}
+
+	if endedStreams[syncStreamName] {
+		if err = c.RestartSync(); err != nil && err != errSyncNotStarted {
+			walletlog.Log.Error("Error restarting stream after reconnecting to dcrwallet",
+				logging.Fields{"server": c.serverAddress, "stream": syncStreamName, "error": err.Error()})
+		} else {
+			delete(endedStreams, syncStreamName)
+		}
+	}
+}
@@ -1,11 +1,19 @@
 package dcrwalletrpc
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
 	"path/filepath"
+	"strings"
 
-	"context"
+	"github.com/decred/dcrwallet/netparams"
 	"github.com/jessevdk/go-flags"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/logging"
+	"github.com/raedahgroup/godcr/app/walletmediums/walletlog"
 )
 
 type ExplicitString struct {
@@ -14,9 +22,13 @@ type ExplicitString struct {
 }
 
 type walletConfig struct {
+	AppDataDir       string          `long:"appdata" description:"Application data directory for wallet config, databases and logs"`
+	TestNet          bool            `long:"testnet" description:"Use the test network"`
+	SimNet           bool            `long:"simnet" description:"Use the simulation test network"`
 	GRPCListeners    []string        `long:"grpclisten" description:"Listen for gRPC connections on this interface/port"`
 	DisableServerTLS bool            `long:"noservertls" description:"Disable TLS for the RPC servers -- NOTE: This is only allowed if the RPC server is bound to localhost"`
 	RPCCert          *ExplicitString `long:"rpccert" description:"File containing the certificate file"`
+	ClientCAFile     string          `long:"clientcafile" description:"File containing Certificate Authorities to verify TLS client certificates"`
 
 	TBOpts struct{} `group:"Ticket Buyer Options" namespace:"ticketbuyer"`
 }
@@ -29,31 +41,126 @@ var (
 	walletConfigFilePath = filepath.Join(config.DefaultDcrwalletAppDataDir, defaultWalletConfigFilename)
 )
 
-func parseDcrWalletConfigAndConnect(ctx context.Context, useRpcCert string, disableTls bool) *WalletRPCClient {
-	wConfig := walletConfig{}
+// parseDcrWalletConfigAndConnect connects to dcrwallet with the settings read from dcrwallet.conf by DiscoverRPCSettings.
+// The godcr config file is not updated, the settings are logged so they can be saved by running godcr rpcsetup.
+func parseDcrWalletConfigAndConnect(ctx context.Context, tlsOptions rpcTLSOptions) *WalletRPCClient {
+	settings, err := DiscoverRPCSettings(ctx, "", tlsOptions.clientCert, tlsOptions.clientKey)
+	if err != nil {
+		return nil
+	}
 
-	parser := flags.NewParser(&wConfig, flags.IgnoreUnknown)
-	err := flags.NewIniParser(parser).ParseFile(walletConfigFilePath)
+	if tlsOptions.serverCert == "" {
+		tlsOptions.serverCert = settings.Cert
+	}
+	tlsOptions.noTLS = settings.NoTLS
+
+	walletRPCClient, err := createConnection(ctx, settings.Server, tlsOptions)
 	if err != nil {
 		return nil
 	}
 
-	var rpcCert string
-	if useRpcCert != "" {
-		rpcCert = useRpcCert
-	} else if wConfig.RPCCert != nil {
-		rpcCert = wConfig.RPCCert.Value
+	walletlog.Log.Info("Connected to dcrwallet with the settings in dcrwallet.conf, run godcr rpcsetup to save them to the godcr config file",
+		logging.Fields{"server": settings.Server, "network": settings.Network})
+	return walletRPCClient
+}
+
+// DcrwalletRPCSettings holds the settings required to connect to the gRPC server of a dcrwallet daemon
+type DcrwalletRPCSettings struct {
+	Server             string
+	Cert               string
+	NoTLS              bool
+	Network            string
+	ClientAuthRequired bool // dcrwallet only accepts connections from clients with a certificate signed by its clientcafile
+}
+
+// DiscoverRPCSettings reads the gRPC listeners, certificate path and network from the dcrwallet config file at `walletConfigFile`,
+// or from dcrwallet.conf in the default dcrwallet appdata dir if `walletConfigFile` is empty, and returns the settings
+// of the first listener that a connection can be established to. dcrwallet's defaults are used for settings
+// that are not set in the config file, or if the config file does not exist.
+// `clientCert` and `clientKey` are presented to dcrwallet if set, they are required if dcrwallet authenticates clients.
+func DiscoverRPCSettings(ctx context.Context, walletConfigFile, clientCert, clientKey string) (*DcrwalletRPCSettings, error) {
+	if walletConfigFile == "" {
+		walletConfigFile = walletConfigFilePath
 	}
 
-	for _, address := range wConfig.GRPCListeners {
-		walletRPCClient, _ := createConnection(ctx, address, rpcCert, disableTls)
-		if walletRPCClient != nil {
-			config.UpdateConfigFile(func(config *config.ConfFileOptions) {
-				config.WalletRPCServer = address
-			})
-			return walletRPCClient
+	wConfig := walletConfig{}
+	parser := flags.NewParser(&wConfig, flags.IgnoreUnknown)
+	err := flags.NewIniParser(parser).ParseFile(walletConfigFile)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error reading dcrwallet config file: %s", err.Error())
+	}
+
+	activeNet := &netparams.MainNetParams
+	if wConfig.TestNet {
+		activeNet = &netparams.TestNet3Params
+	} else if wConfig.SimNet {
+		activeNet = &netparams.SimNetParams
+	}
+
+	appDataDir := config.DefaultDcrwalletAppDataDir
+	if wConfig.AppDataDir != "" {
+		appDataDir = expandHomeDir(wConfig.AppDataDir)
+	}
+
+	rpcCert := filepath.Join(appDataDir, "rpc.cert")
+	if wConfig.RPCCert != nil && wConfig.RPCCert.Value != "" {
+		rpcCert = expandHomeDir(wConfig.RPCCert.Value)
+	}
+
+	listeners := wConfig.GRPCListeners
+	if len(listeners) == 0 {
+		listeners = []string{net.JoinHostPort("localhost", activeNet.GRPCServerPort)}
+	}
+
+	var connectionErrors []string
+	for _, address := range listeners {
+		walletRPCClient, err := createConnection(ctx, address, rpcTLSOptions{
+			noTLS:      wConfig.DisableServerTLS,
+			serverCert: rpcCert,
+			clientCert: clientCert,
+			clientKey:  clientKey,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			connectionErrors = append(connectionErrors, err.Error())
+			continue
+		}
+
+		// the connection is only used to check the settings, close it on every path
+		network, err := getNetParam(walletRPCClient.walletService)
+		walletRPCClient.conn.Close()
+		if err != nil {
+			connectionErrors = append(connectionErrors, err.Error())
+			continue
 		}
+
+		return &DcrwalletRPCSettings{
+			Server:             address,
+			Cert:               rpcCert,
+			NoTLS:              wConfig.DisableServerTLS,
+			Network:            network.Name,
+			ClientAuthRequired: wConfig.ClientCAFile != "",
+		}, nil
+	}
+
+	if wConfig.ClientCAFile != "" && clientCert == "" {
+		connectionErrors = append(connectionErrors, "dcrwallet requires a client certificate, run godcr rpcclientcert to generate one")
+	}
+	return nil, errors.New("cannot connect to dcrwallet with the settings in its config file, is dcrwallet running?\n" +
+		strings.Join(connectionErrors, "\n"))
+}
+
+// expandHomeDir replaces a leading ~ in `path` with the current user's home directory, as dcrwallet does for paths in its config file
+func expandHomeDir(path string) string {
+	if !strings.HasPrefix(path, "~") {
+		return filepath.Clean(path)
 	}
 
-	return nil
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Clean(path)
+	}
+	return filepath.Join(homeDir, path[1:])
 }
@@ -2,11 +2,11 @@ package dcrwalletrpc
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrwallet/netparams"
@@ -15,23 +15,57 @@ import (
 	"github.com/raedahgroup/dcrlibwallet/txindex"
 	"github.com/raedahgroup/dcrlibwallet/utils"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/logging"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/app/walletmediums/walletlog"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 )
 
+// logMedium identifies this wallet medium in log entries
+const logMedium = "dcrwalletrpc"
+
 // WalletRPCClient implements `WalletMiddleware` using `mobilewallet.LibWallet` as medium for connecting to a decred wallet
 // Functions relating to operations that can be performed on a wallet are defined in `walletfunctions.go`
 // Other wallet-related functions are defined in `walletloader.go`
 type WalletRPCClient struct {
+	conn          *grpc.ClientConn
 	walletLoader  walletrpc.WalletLoaderServiceClient
 	walletService walletrpc.WalletServiceClient
+	votingService walletrpc.VotingServiceClient
+	agendaService walletrpc.AgendaServiceClient
 	walletOpen    bool
 	activeNet     *netparams.Params
-
-	numberOfPeers int32
-	syncListener  *defaultsynclistener.DefaultSyncListener
-
-	txIndexDB              *txindex.DB
+	serverAddress string
+
+	connectionStateMu sync.Mutex
+	connectionState   string
+	endedStreams      chan string
+
+	syncListener *defaultsynclistener.DefaultSyncListener
+	syncOptions  config.SyncOptions
+
+	syncMu      sync.Mutex
+	syncCtx     context.Context
+	syncShowLog bool
+	cancelSync  context.CancelFunc
+
+	// peersMu guards numberOfPeers and connectedPeers, which are updated by the sync stream goroutine
+	// and read by WalletConnectionInfo
+	peersMu        sync.Mutex
+	numberOfPeers  int32
+	connectedPeers map[string]struct{}
+
+	// txIndexMu guards txIndexDB, which is closed and replaced when the tx index is rebuilt
+	// while the tx notification listener may be saving txs to it
+	txIndexMu     sync.RWMutex
+	txIndexDB     *txindex.DB
+	txIndexDbPath string
+
+	// listenersMu guards the listeners, which are set by the interfaces while the tx notification listener calls them
+	listenersMu            sync.Mutex
 	txNotificationListener TransactionListener
+	chainReorgListener     func(reorg *walletcore.ChainReorg)
 }
 
 // Connect establishes gRPC connection to a running dcrwallet daemon at the specified address,
@@ -39,34 +73,51 @@ type WalletRPCClient struct {
 // the RPC address is retreived from dcrwallet config file and if this fail, the default address is used.
 // returns an instance of `dcrwalletrpc.Client`
 func Connect(ctx context.Context, cfg *config.Config) (walletRPCClient *WalletRPCClient, err error) {
+	tlsOptions := tlsOptionsFromConfig(cfg)
+
 	defer func() {
 		if walletRPCClient != nil {
+			walletRPCClient.syncOptions = cfg.SyncOptions()
+			walletlog.Log.Info("Connected to dcrwallet", logging.Fields{
+				"server":      walletRPCClient.serverAddress,
+				"tls":         !tlsOptions.noTLS,
+				"client_cert": tlsOptions.clientCert != "",
+			})
+
 			// wallet library is setup, prepare it for use by opening
 			err = openWalletIfExist(ctx, walletRPCClient, cfg.AppDataDir)
+			if err == nil && walletRPCClient.walletOpen {
+				walletlog.Log.Info("Wallet opened", logging.Fields{
+					"medium":  logMedium,
+					"network": walletRPCClient.NetType(),
+					"server":  walletRPCClient.serverAddress,
+				})
+			}
+			go walletRPCClient.monitorConnection(ctx)
 		}
 	}()
 
-	walletRPCClient, err = createConnection(ctx, cfg.WalletRPCServer, cfg.WalletRPCCert, cfg.NoWalletRPCTLS)
+	walletRPCClient, err = createConnection(ctx, cfg.WalletRPCServer, tlsOptions)
 	if err == nil {
 		return
 	}
 
-	walletRPCClient = parseDcrWalletConfigAndConnect(ctx, cfg.WalletRPCCert, cfg.NoWalletRPCTLS)
+	walletRPCClient = parseDcrWalletConfigAndConnect(ctx, tlsOptions)
 	if walletRPCClient != nil {
 		return
 	}
 
-	walletRPCClient = connectToDefaultAddresses(ctx, cfg.WalletRPCCert, cfg.NoWalletRPCTLS)
+	walletRPCClient = connectToDefaultAddresses(ctx, tlsOptions)
 	return
 }
 
-func createConnection(ctx context.Context, rpcAddress, rpcCert string, noTLS bool) (*WalletRPCClient, error) {
-	if !noTLS && rpcCert == "" {
-		return nil, errors.New("set dcrwallet rpc certificate path in config file or disable tls for dcrwallet connection")
+func createConnection(ctx context.Context, rpcAddress string, tlsOptions rpcTLSOptions) (*WalletRPCClient, error) {
+	if err := tlsOptions.validate(); err != nil {
+		return nil, err
 	}
 
 	// perform rpc connection in background, user might shutdown before connection is complete
-	go connectToRPC(rpcAddress, rpcCert, noTLS)
+	go connectToRPC(rpcAddress, tlsOptions)
 
 	select {
 	case <-ctx.Done():
@@ -78,16 +129,23 @@ func createConnection(ctx context.Context, rpcAddress, rpcCert string, noTLS boo
 		}
 
 		return &WalletRPCClient{
-			walletLoader:  walletrpc.NewWalletLoaderServiceClient(connectionResult.conn),
-			walletService: walletrpc.NewWalletServiceClient(connectionResult.conn),
+			conn:            connectionResult.conn,
+			walletLoader:    walletrpc.NewWalletLoaderServiceClient(connectionResult.conn),
+			walletService:   walletrpc.NewWalletServiceClient(connectionResult.conn),
+			votingService:   walletrpc.NewVotingServiceClient(connectionResult.conn),
+			agendaService:   walletrpc.NewAgendaServiceClient(connectionResult.conn),
+			serverAddress:   rpcAddress,
+			connectionState: walletcore.WalletRPCStateConnected,
+			endedStreams:    make(chan string),
+			connectedPeers:  map[string]struct{}{},
 		}, nil
 	}
 }
 
-func connectToDefaultAddresses(ctx context.Context, rpcCert string, noTLS bool) (walletRPCClient *WalletRPCClient) {
+func connectToDefaultAddresses(ctx context.Context, tlsOptions rpcTLSOptions) (walletRPCClient *WalletRPCClient) {
 	// try connecting with default testnet3 params
 	testnetAddress := net.JoinHostPort("localhost", netparams.TestNet3Params.GRPCServerPort)
-	walletRPCClient, _ = createConnection(ctx, testnetAddress, rpcCert, noTLS)
+	walletRPCClient, _ = createConnection(ctx, testnetAddress, tlsOptions)
 	if walletRPCClient != nil {
 		config.UpdateConfigFile(func(config *config.ConfFileOptions) {
 			config.WalletRPCServer = testnetAddress
@@ -97,7 +155,7 @@ func connectToDefaultAddresses(ctx context.Context, rpcCert string, noTLS bool)
 
 	// try connecting with default mainnet params
 	mainnetAddress := net.JoinHostPort("localhost", netparams.MainNetParams.GRPCServerPort)
-	walletRPCClient, _ = createConnection(ctx, mainnetAddress, rpcCert, noTLS)
+	walletRPCClient, _ = createConnection(ctx, mainnetAddress, tlsOptions)
 	if walletRPCClient != nil {
 		config.UpdateConfigFile(func(config *config.ConfFileOptions) {
 			config.WalletRPCServer = mainnetAddress
@@ -156,25 +214,21 @@ func finalizeWalletSetup(ctx context.Context, c *WalletRPCClient, appDataDir str
 	// important to do it at this point before wallet operations
 	// such as sync and transaction notification are triggered
 	// because those operations will need to access the tx index db.
-	txIndexDbPath := filepath.Join(appDataDir, "rpc-tx-index", txindex.DbName)
-	os.MkdirAll(filepath.Dir(txIndexDbPath), os.ModePerm) // create directory if not exist
-
-	generateWalletAddress := func() (string, error) {
-		return c.GenerateNewAddress(0) // use default account
-	}
-	addressMatchesWallet := func(address string) (bool, error) {
-		addressInfo, err := c.AddressInfo(address)
-		if err != nil {
-			return false, err
+	c.txIndexDbPath = filepath.Join(appDataDir, "rpc-tx-index", txindex.DbName)
+	os.MkdirAll(filepath.Dir(c.txIndexDbPath), os.ModePerm) // create directory if not exist
+
+	if err := c.openTxIndexDB(); err != nil {
+		// the db file may be corrupted, start over with an empty index
+		fmt.Printf("Tx index db could not be opened, rebuilding it: %s.\n", err.Error())
+		os.Remove(c.txIndexDbPath)
+		if err = c.openTxIndexDB(); err != nil {
+			return err
 		}
-		return addressInfo.IsMine, nil
 	}
 
-	txIndexDB, err := txindex.Initialize(txIndexDbPath, generateWalletAddress, addressMatchesWallet)
-	if err != nil {
-		return fmt.Errorf("tx index db initialization failed: %s", err.Error())
+	if err := c.repairTxIndex(ctx); err != nil {
+		fmt.Printf("Tx index check failed: %s.\n", err.Error())
 	}
-	c.txIndexDB = txIndexDB
 
 	// start tx notification listener now,
 	// so we can index txs as the wallet is notified of new/updated txs
@@ -34,22 +34,20 @@ func (c *WalletRPCClient) signAndPublishTransaction(serializedTx []byte, passphr
 		return "", fmt.Errorf("error signing transaction: %s", err.Error())
 	}
 
-	// publish transaction
-	publishRequest := &walletrpc.PublishTransactionRequest{
-		SignedTransaction: signResponse.Transaction,
-	}
+	return c.PublishTransaction(ctx, signResponse.Transaction)
+}
 
-	publishResponse, err := c.walletService.PublishTransaction(ctx, publishRequest)
+func (c *WalletRPCClient) abandonTransaction(ctx context.Context, transactionHash string) error {
+	hash, err := chainhash.NewHashFromStr(transactionHash)
 	if err != nil {
-		return "", fmt.Errorf("error publishing transaction: %s", err.Error())
+		return fmt.Errorf("invalid hash: %s\n%s", transactionHash, err.Error())
 	}
 
-	transactionHash, err := chainhash.NewHash(publishResponse.TransactionHash)
+	_, err = c.walletService.AbandonTransaction(ctx, &walletrpc.AbandonTransactionRequest{TransactionHash: hash[:]})
 	if err != nil {
-		return "", fmt.Errorf("error parsing successful transaction hash: %s", err.Error())
+		return fmt.Errorf("error abandoning transaction: %s", err.Error())
 	}
-
-	return transactionHash.String(), nil
+	return nil
 }
 
 func (c *WalletRPCClient) decodeTransactionWithTxSummary(ctx context.Context, txSummary *walletrpc.TransactionDetails,
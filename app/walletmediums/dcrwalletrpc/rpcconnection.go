@@ -1,13 +1,73 @@
 package dcrwalletrpc
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"time"
 
+	"github.com/raedahgroup/godcr/app/config"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
 
+// rpcTLSOptions determine how the connection to dcrwallet's gRPC server is secured
+type rpcTLSOptions struct {
+	noTLS      bool
+	serverCert string
+
+	// client certificate and key, only required if dcrwallet authenticates clients (dcrwallet's clientcafile option)
+	clientCert string
+	clientKey  string
+}
+
+func tlsOptionsFromConfig(cfg *config.Config) rpcTLSOptions {
+	return rpcTLSOptions{
+		noTLS:      cfg.NoWalletRPCTLS,
+		serverCert: cfg.WalletRPCCert,
+		clientCert: cfg.WalletRPCClientCert,
+		clientKey:  cfg.WalletRPCClientKey,
+	}
+}
+
+func (options rpcTLSOptions) validate() error {
+	if options.noTLS {
+		return nil
+	}
+	if options.serverCert == "" {
+		return errors.New("set dcrwallet rpc certificate path in config file or disable tls for dcrwallet connection")
+	}
+	if (options.clientCert == "") != (options.clientKey == "") {
+		return errors.New("set both walletrpcclientcert and walletrpcclientkey in config file to authenticate with a client certificate")
+	}
+	return nil
+}
+
+// transportCredentials verifies dcrwallet's certificate against the server certificate file
+// and presents the client certificate to dcrwallet if one is set
+func (options rpcTLSOptions) transportCredentials() (credentials.TransportCredentials, error) {
+	serverCert, err := ioutil.ReadFile(options.serverCert)
+	if err != nil {
+		return nil, fmt.Errorf("error reading dcrwallet certificate: %s", err.Error())
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(serverCert) {
+		return nil, fmt.Errorf("no valid certificate found in %s", options.serverCert)
+	}
+
+	tlsConfig := &tls.Config{RootCAs: rootCAs}
+	if options.clientCert != "" {
+		clientKeyPair, err := tls.LoadX509KeyPair(options.clientCert, options.clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %s", err.Error())
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientKeyPair}
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
 type rpcConnectionResult struct {
 	err  error
 	conn *grpc.ClientConn
@@ -18,7 +78,7 @@ var (
 	rpcConnectionTimeout = 5 * time.Second
 )
 
-func connectToRPC(rpcAddress, rpcCert string, noTLS bool) {
+func connectToRPC(rpcAddress string, tlsOptions rpcTLSOptions) {
 	var conn *grpc.ClientConn
 	var err error
 
@@ -37,16 +97,20 @@ func connectToRPC(rpcAddress, rpcCert string, noTLS bool) {
 
 	// block until connection is established
 	// return error if connection cannot be established after `rpcConnectionTimeoutSeconds` seconds
+	// grpc re-establishes dropped connections with exponential backoff, limit the delay between attempts
+	// so that godcr reconnects soon after dcrwallet is restarted
 	grpcConnectionOptions := []grpc.DialOption{
 		grpc.WithBlock(),
 		grpc.WithTimeout(rpcConnectionTimeout),
+		grpc.WithBackoffMaxDelay(reconnectMaxDelay),
 	}
 
-	if noTLS {
+	if tlsOptions.noTLS {
 		grpcConnectionOptions = append(grpcConnectionOptions, grpc.WithInsecure())
 		conn, err = grpc.Dial(rpcAddress, grpcConnectionOptions...)
 	} else {
-		creds, err := credentials.NewClientTLSFromFile(rpcCert, "")
+		var creds credentials.TransportCredentials
+		creds, err = tlsOptions.transportCredentials()
 		if err != nil {
 			return
 		}
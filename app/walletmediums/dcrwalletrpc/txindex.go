@@ -4,13 +4,184 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrwallet/rpc/walletrpc"
 	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/dcrlibwallet/txindex"
+	"github.com/raedahgroup/godcr/app/walletcore"
 )
 
+// txIndexCheckPageSize is the number of indexed transactions read at a time when checking the tx index
+const txIndexCheckPageSize = 500
+
+// openTxIndexDB opens the tx index db at c.txIndexDbPath, creating it if it does not exist
+func (c *WalletRPCClient) openTxIndexDB() error {
+	generateWalletAddress := func() (string, error) {
+		return c.GenerateNewAddress(0) // use default account
+	}
+	addressMatchesWallet := func(address string) (bool, error) {
+		addressInfo, err := c.AddressInfo(address)
+		if err != nil {
+			return false, err
+		}
+		return addressInfo.IsMine, nil
+	}
+
+	txIndexDB, err := txindex.Initialize(c.txIndexDbPath, generateWalletAddress, addressMatchesWallet)
+	if err != nil {
+		return fmt.Errorf("tx index db initialization failed: %s", err.Error())
+	}
+	c.txIndexDB = txIndexDB
+	return nil
+}
+
+// useTxIndex calls `fn` with the tx index db, preventing the db from being closed or replaced until `fn` returns
+func (c *WalletRPCClient) useTxIndex(fn func(txIndexDB *txindex.DB) error) error {
+	c.txIndexMu.RLock()
+	defer c.txIndexMu.RUnlock()
+	return fn(c.txIndexDB)
+}
+
+// repairTxIndex rebuilds the tx index if it does not have the same transactions as the wallet
+func (c *WalletRPCClient) repairTxIndex(ctx context.Context) error {
+	health, err := c.CheckTxIndex(ctx)
+	if err != nil {
+		return err
+	}
+	if health.Healthy() {
+		return nil
+	}
+
+	fmt.Printf("Tx index is out of sync with the wallet (%d missing, %d unknown transactions), rebuilding it.\n",
+		len(health.MissingTxHashes), len(health.UnknownTxHashes))
+	return c.RebuildTxIndex(ctx, nil)
+}
+
+// CheckTxIndex compares the transactions in the tx index with the wallet's transactions.
+// Only transactions mined before the block the next indexing starts from are compared,
+// transactions in later blocks and unmined transactions are indexed on the next sync.
+func (c *WalletRPCClient) CheckTxIndex(ctx context.Context) (*walletcore.TxIndexHealth, error) {
+	var checkToHeight int32
+	indexedHashes := make(map[string]bool)
+	err := c.useTxIndex(func(txIndexDB *txindex.DB) (err error) {
+		checkToHeight, err = txIndexDB.ReadIndexingStartBlock()
+		if err != nil {
+			return fmt.Errorf("error reading last indexed block height: %s", err.Error())
+		}
+
+		// read the index a page at a time rather than loading every indexed tx into memory at once
+		for offset := int32(0); ; offset += txIndexCheckPageSize {
+			indexedTxs, err := txIndexDB.Read(offset, txIndexCheckPageSize, nil)
+			if err != nil {
+				return fmt.Errorf("error reading indexed transactions: %s", err.Error())
+			}
+			for _, tx := range indexedTxs {
+				if tx.BlockHeight >= 0 && tx.BlockHeight < checkToHeight {
+					indexedHashes[tx.Hash] = true
+				}
+			}
+			if len(indexedTxs) < txIndexCheckPageSize {
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	health := &walletcore.TxIndexHealth{
+		CheckedToHeight: checkToHeight,
+		IndexedCount:    len(indexedHashes),
+	}
+	if checkToHeight <= 0 {
+		// nothing has been indexed yet
+		return health, nil
+	}
+
+	txStream, err := c.walletService.GetTransactions(ctx, &walletrpc.GetTransactionsRequest{
+		StartingBlockHeight: 0,
+		EndingBlockHeight:   checkToHeight - 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching wallet transactions: %s", err.Error())
+	}
+
+	walletHashes := make(map[string]bool)
+	for {
+		in, err := txStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error fetching wallet transactions: %s", err.Error())
+		}
+		if in.MinedTransactions == nil {
+			continue
+		}
+
+		for _, txSummary := range in.MinedTransactions.Transactions {
+			hash, err := chainhash.NewHash(txSummary.Hash)
+			if err != nil {
+				return nil, fmt.Errorf("invalid transaction hash from wallet: %s", err.Error())
+			}
+			walletHashes[hash.String()] = true
+			if !indexedHashes[hash.String()] {
+				health.MissingTxHashes = append(health.MissingTxHashes, hash.String())
+			}
+		}
+	}
+
+	health.WalletCount = len(walletHashes)
+	for hash := range indexedHashes {
+		if !walletHashes[hash] {
+			health.UnknownTxHashes = append(health.UnknownTxHashes, hash)
+		}
+	}
+	return health, nil
+}
+
+// RebuildTxIndex deletes the tx index db and indexes all the wallet's transactions into a new db.
+// Txs saved by the tx notification listener while the db is replaced are saved to the new db once it is open.
+func (c *WalletRPCClient) RebuildTxIndex(ctx context.Context, indexProgress func(indexedThrough, bestBlock int32)) error {
+	bestBlock, err := c.BestBlock()
+	if err != nil {
+		return fmt.Errorf("error fetching best block: %s", err.Error())
+	}
+
+	if err = c.replaceTxIndexDB(); err != nil {
+		return err
+	}
+
+	var progress func(int32)
+	if indexProgress != nil {
+		progress = func(indexedThrough int32) {
+			indexProgress(indexedThrough, int32(bestBlock))
+		}
+	}
+	return c.indexTransactions(ctx, 0, int32(bestBlock), false, progress, func() {})
+}
+
+// replaceTxIndexDB closes and deletes the tx index db and opens a new, empty one in its place
+func (c *WalletRPCClient) replaceTxIndexDB() error {
+	c.txIndexMu.Lock()
+	defer c.txIndexMu.Unlock()
+
+	if err := c.txIndexDB.Close(); err != nil {
+		return fmt.Errorf("error closing tx index db: %s", err.Error())
+	}
+	if err := os.Remove(c.txIndexDbPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error deleting tx index db: %s", err.Error())
+	}
+	return c.openTxIndexDB()
+}
+
+// indexTransactions saves the wallet's transactions mined between `startBlockHeight` and `endBlockHeight` to the tx index db,
+// calling `indexProgress`, if not nil, with the height of each block indexed.
+// Indexing starts from the last index point if `startBlockHeight` is -1.
 func (c *WalletRPCClient) indexTransactions(ctx context.Context, startBlockHeight int32, endBlockHeight int32,
-	showLog bool, afterIndexing func()) (err error) {
+	showLog bool, indexProgress func(indexedThrough int32), afterIndexing func()) (err error) {
 
 	reportProgress := func(formatting string, values ...interface{}) {
 		if showLog {
@@ -26,7 +197,9 @@ func (c *WalletRPCClient) indexTransactions(ctx context.Context, startBlockHeigh
 		}
 
 		// mark current end block height as last index point
-		c.txIndexDB.SaveLastIndexPoint(endBlockHeight)
+		c.useTxIndex(func(txIndexDB *txindex.DB) error {
+			return txIndexDB.SaveLastIndexPoint(endBlockHeight)
+		})
 
 		count, err := c.TransactionCount(nil)
 		if err != nil {
@@ -38,7 +211,10 @@ func (c *WalletRPCClient) indexTransactions(ctx context.Context, startBlockHeigh
 	}()
 
 	if startBlockHeight == -1 {
-		startBlockHeight, err = c.txIndexDB.ReadIndexingStartBlock()
+		err = c.useTxIndex(func(txIndexDB *txindex.DB) (err error) {
+			startBlockHeight, err = txIndexDB.ReadIndexingStartBlock()
+			return
+		})
 		if err != nil {
 			reportProgress("Error reading block height to start tx indexing :%v", err)
 			return err
@@ -57,14 +233,16 @@ func (c *WalletRPCClient) indexTransactions(ctx context.Context, startBlockHeigh
 
 	var totalIndexed int32
 	indexTx := func(tx *txhelper.Transaction) error {
-		err = c.txIndexDB.SaveOrUpdate(tx)
+		err = c.useTxIndex(func(txIndexDB *txindex.DB) error {
+			return txIndexDB.SaveOrUpdate(tx)
+		})
 		if err != nil {
 			reportProgress("Save or update tx error :%v", err)
 			return err
 		}
 
 		totalIndexed++
-		if c.syncListener == nil {
+		if c.syncListener != nil {
 			c.syncListener.OnIndexTransactions(totalIndexed)
 		}
 		return nil
@@ -101,13 +279,18 @@ func (c *WalletRPCClient) indexTransactions(ctx context.Context, startBlockHeigh
 				}
 			}
 
-			err := c.txIndexDB.SaveLastIndexPoint(int32(in.MinedTransactions.Height))
+			err := c.useTxIndex(func(txIndexDB *txindex.DB) error {
+				return txIndexDB.SaveLastIndexPoint(int32(in.MinedTransactions.Height))
+			})
 			if err != nil {
 				reportProgress("Error setting block height for last indexed tx: ", err)
 				return err
 			}
 
 			reportProgress("Transaction index caught up to %d", in.MinedTransactions.Height)
+			if indexProgress != nil {
+				indexProgress(int32(in.MinedTransactions.Height))
+			}
 		}
 
 		// process unmined txs and index
@@ -6,6 +6,10 @@ import (
 
 	"github.com/decred/dcrwallet/rpc/walletrpc"
 	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/dcrlibwallet/txindex"
+	"github.com/raedahgroup/godcr/app/logging"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/app/walletmediums/walletlog"
 )
 
 type TransactionListener interface {
@@ -15,7 +19,22 @@ type TransactionListener interface {
 }
 
 func (c *WalletRPCClient) RegisterTxNotificationListener(listener TransactionListener) {
+	c.listenersMu.Lock()
 	c.txNotificationListener = listener
+	c.listenersMu.Unlock()
+}
+
+func (c *WalletRPCClient) SetChainReorgListener(onChainReorg func(reorg *walletcore.ChainReorg)) {
+	c.listenersMu.Lock()
+	c.chainReorgListener = onChainReorg
+	c.listenersMu.Unlock()
+}
+
+// listeners returns the tx notification and chain reorg listeners currently set, either may be nil
+func (c *WalletRPCClient) listeners() (TransactionListener, func(reorg *walletcore.ChainReorg)) {
+	c.listenersMu.Lock()
+	defer c.listenersMu.Unlock()
+	return c.txNotificationListener, c.chainReorgListener
 }
 
 func (c *WalletRPCClient) ListenForTxNotification(ctx context.Context) error {
@@ -40,8 +59,19 @@ func (c *WalletRPCClient) startTxNotificationListener(ctx context.Context,
 		}
 
 		if err != nil {
-			// todo use logger, similar logging should be done across dcrwalletrpc and dcrlibwallet functions
-			fmt.Printf("error reading tx notification update: %s\n", err.Error())
+			// the stream is broken, listening is resumed by monitorConnection when the connection to dcrwallet is restored
+			walletlog.Log.Error("Error reading tx notification update", logging.Fields{"medium": logMedium, "error": err.Error()})
+			c.streamEnded(ctx, txNotificationStreamName, err)
+			return
+		}
+
+		txNotificationListener, chainReorgListener := c.listeners()
+
+		// move txs mined in detached blocks back to unmined before processing the blocks that replaced them,
+		// txs included in the attached blocks get their new block height below
+		var reorg *walletcore.ChainReorg
+		if len(txNotification.DetachedBlocks) > 0 {
+			reorg = c.processDetachedBlocks(txNotification)
 		}
 
 		// process unmined tx gotten from notification
@@ -51,17 +81,17 @@ func (c *WalletRPCClient) startTxNotificationListener(ctx context.Context,
 				continue
 			}
 
-			err = c.txIndexDB.SaveOrUpdate(decodedTx)
-			if err == nil && c.txNotificationListener != nil {
-				c.txNotificationListener.OnTransaction(decodedTx)
+			err = c.saveIndexedTx(decodedTx)
+			if err == nil && txNotificationListener != nil {
+				txNotificationListener.OnTransaction(decodedTx)
 				continue
 			}
 		}
 
 		// process mined tx gotten from notification
 		for _, block := range txNotification.AttachedBlocks {
-			if c.txNotificationListener != nil {
-				c.txNotificationListener.OnBlockAttached(block.Height, block.Timestamp)
+			if txNotificationListener != nil {
+				txNotificationListener.OnBlockAttached(block.Height, block.Timestamp)
 			}
 
 			blockHash := block.Hash
@@ -71,15 +101,94 @@ func (c *WalletRPCClient) startTxNotificationListener(ctx context.Context,
 					continue
 				}
 
-				err = c.txIndexDB.SaveOrUpdate(decodedTx)
+				err = c.saveIndexedTx(decodedTx)
 				if err != nil {
 					continue
 				}
 
-				if c.txNotificationListener != nil {
-					c.txNotificationListener.OnTransactionConfirmed(decodedTx.Hash, block.Height)
+				if txNotificationListener != nil {
+					txNotificationListener.OnTransactionConfirmed(decodedTx.Hash, block.Height)
 				}
 			}
 		}
+
+		if reorg != nil && chainReorgListener != nil {
+			chainReorgListener(reorg)
+		}
+	}
+}
+
+// saveIndexedTx saves a tx received in a notification to the tx index db
+func (c *WalletRPCClient) saveIndexedTx(tx *txhelper.Transaction) error {
+	return c.useTxIndex(func(txIndexDB *txindex.DB) error {
+		return txIndexDB.SaveOrUpdate(tx)
+	})
+}
+
+// processDetachedBlocks marks indexed txs mined above the fork point of a chain reorganisation as unmined.
+// Detached blocks are only identified by hash, so the fork point is taken to be the block before the lowest attached block,
+// or the current best block if no blocks were attached.
+func (c *WalletRPCClient) processDetachedBlocks(txNotification *walletrpc.TransactionNotificationsResponse) *walletcore.ChainReorg {
+	forkHeight := int32(-1)
+	newBestHeight := int32(-1)
+	for _, block := range txNotification.AttachedBlocks {
+		if forkHeight == -1 || block.Height-1 < forkHeight {
+			forkHeight = block.Height - 1
+		}
+		if block.Height > newBestHeight {
+			newBestHeight = block.Height
+		}
+	}
+	if forkHeight == -1 {
+		bestBlock, err := c.BestBlock()
+		if err != nil {
+			walletlog.Log.Error("Error reading best block after chain reorganisation", logging.Fields{"medium": logMedium, "error": err.Error()})
+			return nil
+		}
+		forkHeight = int32(bestBlock)
+		newBestHeight = int32(bestBlock)
+	}
+
+	reorg := &walletcore.ChainReorg{
+		ForkHeight:     forkHeight,
+		DetachedBlocks: len(txNotification.DetachedBlocks),
+		NewBestHeight:  newBestHeight,
+	}
+
+	// keep the tx index db from being replaced while txs are read and updated
+	c.txIndexMu.RLock()
+	defer c.txIndexMu.RUnlock()
+
+	indexedCount, err := c.txIndexDB.CountTx(nil)
+	if err != nil {
+		walletlog.Log.Error("Error counting indexed txs after chain reorganisation", logging.Fields{"medium": logMedium, "error": err.Error()})
+		return reorg
+	}
+	indexedTxs, err := c.txIndexDB.Read(0, int32(indexedCount), nil)
+	if err != nil {
+		walletlog.Log.Error("Error reading indexed txs after chain reorganisation", logging.Fields{"medium": logMedium, "error": err.Error()})
+		return reorg
+	}
+
+	for _, tx := range indexedTxs {
+		if tx.BlockHeight <= forkHeight {
+			continue
+		}
+
+		tx.BlockHeight = -1
+		if err = c.txIndexDB.SaveOrUpdate(tx); err != nil {
+			walletlog.Log.Error("Error updating tx after chain reorganisation",
+				logging.Fields{"medium": logMedium, "tx_hash": tx.Hash, "error": err.Error()})
+			continue
+		}
+		reorg.AffectedTxHashes = append(reorg.AffectedTxHashes, tx.Hash)
+	}
+
+	// index from the fork point on the next sync in case any attached block could not be processed
+	if err = c.txIndexDB.SaveLastIndexPoint(forkHeight); err != nil {
+		walletlog.Log.Error("Error resetting last index point after chain reorganisation",
+			logging.Fields{"medium": logMedium, "error": err.Error()})
 	}
+
+	return reorg
 }
@@ -3,6 +3,7 @@ package dcrwalletrpc
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -87,6 +88,29 @@ func (c *WalletRPCClient) NextAccount(accountName string, passphrase string) (ui
 	return nextAccount.AccountNumber, nil
 }
 
+func (c *WalletRPCClient) RenameAccount(accountNumber uint32, newName string) error {
+	req := &walletrpc.RenameAccountRequest{
+		AccountNumber: accountNumber,
+		NewName:       newName,
+	}
+
+	_, err := c.walletService.RenameAccount(context.Background(), req)
+	return err
+}
+
+func (c *WalletRPCClient) AccountExtendedPubKey(accountNumber uint32) (string, error) {
+	req := &walletrpc.GetAccountExtendedPubKeyRequest{
+		AccountNumber: accountNumber,
+	}
+
+	res, err := c.walletService.GetAccountExtendedPubKey(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+
+	return res.AccExtendedPubKey, nil
+}
+
 func (c *WalletRPCClient) AccountNumber(accountName string) (uint32, error) {
 	req := &walletrpc.AccountNumberRequest{
 		AccountName: accountName,
@@ -178,6 +202,42 @@ func (c *WalletRPCClient) GenerateNewAddress(account uint32) (string, error) {
 	return nextAddress.Address, nil
 }
 
+func (c *WalletRPCClient) ImportPrivateKey(ctx context.Context, privateKeyWif, passphrase string, rescan bool, rescanFromHeight int32) error {
+	req := &walletrpc.ImportPrivateKeyRequest{
+		Passphrase:    []byte(passphrase),
+		Account:       walletcore.ImportedAccountNumber,
+		PrivateKeyWif: privateKeyWif,
+		Rescan:        rescan,
+		ScanFrom:      rescanFromHeight,
+	}
+
+	_, err := c.walletService.ImportPrivateKey(ctx, req)
+	if err != nil {
+		return fmt.Errorf("error importing private key: %s", err.Error())
+	}
+	return nil
+}
+
+func (c *WalletRPCClient) ImportScript(ctx context.Context, scriptHex, passphrase string, rescan bool, rescanFromHeight int32) error {
+	script, err := hex.DecodeString(scriptHex)
+	if err != nil {
+		return fmt.Errorf("invalid script hex: %s", err.Error())
+	}
+
+	req := &walletrpc.ImportScriptRequest{
+		Passphrase: []byte(passphrase),
+		Script:     script,
+		Rescan:     rescan,
+		ScanFrom:   rescanFromHeight,
+	}
+
+	_, err = c.walletService.ImportScript(ctx, req)
+	if err != nil {
+		return fmt.Errorf("error importing script: %s", err.Error())
+	}
+	return nil
+}
+
 func (c *WalletRPCClient) UnspentOutputs(account uint32, targetAmount int64, requiredConfirmations int32) ([]*walletcore.UnspentOutput, error) {
 	utxoStream, err := c.unspentOutputStream(account, targetAmount, requiredConfirmations)
 	if err != nil {
@@ -335,12 +395,106 @@ func (c *WalletRPCClient) SendFromUTXOs(sourceAccount uint32, requiredConfirmati
 	return c.signAndPublishTransaction(txBuf.Bytes(), passphrase)
 }
 
-func (c *WalletRPCClient) TransactionCount(filter *txindex.ReadFilter) (int, error) {
-	return c.txIndexDB.CountTx(filter)
+func (c *WalletRPCClient) PublishTransaction(ctx context.Context, signedTx []byte) (string, error) {
+	publishRequest := &walletrpc.PublishTransactionRequest{
+		SignedTransaction: signedTx,
+	}
+
+	publishResponse, err := c.walletService.PublishTransaction(ctx, publishRequest)
+	if err != nil {
+		return "", fmt.Errorf("error publishing transaction: %s", err.Error())
+	}
+
+	transactionHash, err := chainhash.NewHash(publishResponse.TransactionHash)
+	if err != nil {
+		return "", fmt.Errorf("error parsing successful transaction hash: %s", err.Error())
+	}
+
+	return transactionHash.String(), nil
+}
+
+func (c *WalletRPCClient) UnminedTransactions(ctx context.Context) ([]*walletcore.Transaction, error) {
+	// a negative starting height counts back from the tip and omitting the ending height includes unmined transactions,
+	// so this streams the transactions in the tip block (ignored) followed by all unmined transactions
+	txStream, err := c.walletService.GetTransactions(ctx, &walletrpc.GetTransactionsRequest{StartingBlockHeight: -1})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching unmined transactions: %s", err.Error())
+	}
+
+	var unminedTxs []*walletcore.Transaction
+	for {
+		in, err := txStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error fetching unmined transactions: %s", err.Error())
+		}
+
+		for _, txSummary := range in.UnminedTransactions {
+			tx, err := c.decodeTransactionWithTxSummary(ctx, txSummary, nil)
+			if err != nil {
+				return nil, err
+			}
+			unminedTxs = append(unminedTxs, walletcore.TxDetails(tx, 0))
+		}
+	}
+
+	return unminedTxs, nil
+}
+
+func (c *WalletRPCClient) RebroadcastUnminedTransactions(ctx context.Context) error {
+	_, err := c.walletService.PublishUnminedTransactions(ctx, &walletrpc.PublishUnminedTransactionsRequest{})
+	if err != nil {
+		return fmt.Errorf("error rebroadcasting unmined transactions: %s", err.Error())
+	}
+	return nil
+}
+
+func (c *WalletRPCClient) AbandonTransaction(ctx context.Context, transactionHash string) error {
+	tx, err := c.GetTransaction(transactionHash)
+	if err != nil {
+		return err
+	}
+	if err = walletcore.CheckAbandonable(tx); err != nil {
+		return err
+	}
+
+	return c.abandonTransaction(ctx, transactionHash)
+}
+
+func (c *WalletRPCClient) BumpTransactionFee(ctx context.Context, transactionHash string, feeRate int64, passphrase string) (string, error) {
+	tx, err := c.GetTransaction(transactionHash)
+	if err != nil {
+		return "", err
+	}
+
+	childTx, err := walletcore.NewCPFPTransaction(tx, feeRate, c.GenerateNewAddress)
+	if err != nil {
+		return "", err
+	}
+
+	serializedTx, err := childTx.Bytes()
+	if err != nil {
+		return "", fmt.Errorf("error serializing transaction: %s", err.Error())
+	}
+	return c.signAndPublishTransaction(serializedTx, passphrase)
+}
+
+func (c *WalletRPCClient) TransactionCount(filter *txindex.ReadFilter) (count int, err error) {
+	err = c.useTxIndex(func(txIndexDB *txindex.DB) error {
+		count, err = txIndexDB.CountTx(filter)
+		return err
+	})
+	return
 }
 
 func (c *WalletRPCClient) TransactionHistory(offset, count int32, filter *txindex.ReadFilter) ([]*walletcore.Transaction, error) {
-	txs, err := c.txIndexDB.Read(offset, count, filter)
+	var txs []*txhelper.Transaction
+	err := c.useTxIndex(func(txIndexDB *txindex.DB) (err error) {
+		txs, err = txIndexDB.Read(offset, count, filter)
+		return
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -376,7 +530,7 @@ func (c *WalletRPCClient) GetTransaction(transactionHash string) (*walletcore.Tr
 	getTxRequest := &walletrpc.GetTransactionRequest{TransactionHash: hash[:]}
 	getTxResponse, err := c.walletService.GetTransaction(ctx, getTxRequest)
 	if isRpcErrorCode(err, codes.NotFound) {
-		return nil, fmt.Errorf("transaction not found")
+		return nil, walletcore.ErrTransactionNotFound
 	} else if err != nil {
 		return nil, err
 	}
@@ -463,6 +617,59 @@ func (c *WalletRPCClient) PurchaseTicket(ctx context.Context, request dcrlibwall
 	return ticketHashes, nil
 }
 
+func (c *WalletRPCClient) VoteChoices(ctx context.Context) ([]*walletcore.Agenda, error) {
+	agendasResponse, err := c.agendaService.Agendas(ctx, &walletrpc.AgendasRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching agendas: %s", err.Error())
+	}
+
+	voteChoicesResponse, err := c.votingService.VoteChoices(ctx, &walletrpc.VoteChoicesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching vote choices: %s", err.Error())
+	}
+
+	voteChoices := make(map[string]string, len(voteChoicesResponse.Choices))
+	for _, choice := range voteChoicesResponse.Choices {
+		voteChoices[choice.AgendaId] = choice.ChoiceId
+	}
+
+	agendas := make([]*walletcore.Agenda, len(agendasResponse.Agendas))
+	for i, agenda := range agendasResponse.Agendas {
+		choices := make([]*walletcore.AgendaChoice, len(agenda.Choices))
+		for j, choice := range agenda.Choices {
+			choices[j] = &walletcore.AgendaChoice{
+				ID:          choice.Id,
+				Description: choice.Description,
+				IsAbstain:   choice.IsAbstain,
+				IsNo:        choice.IsNo,
+			}
+		}
+
+		agendas[i] = &walletcore.Agenda{
+			ID:          agenda.Id,
+			Description: agenda.Description,
+			Choices:     choices,
+			VoteChoice:  voteChoices[agenda.Id],
+			StartTime:   agenda.StartTime,
+			ExpireTime:  agenda.ExpireTime,
+		}
+	}
+
+	return agendas, nil
+}
+
+func (c *WalletRPCClient) SetVoteChoice(ctx context.Context, agendaID, choiceID string) error {
+	_, err := c.votingService.SetVoteChoices(ctx, &walletrpc.SetVoteChoicesRequest{
+		Choices: []*walletrpc.SetVoteChoicesRequest_Choice{
+			{AgendaId: agendaID, ChoiceId: choiceID},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting vote choice: %s", err.Error())
+	}
+	return nil
+}
+
 func (c *WalletRPCClient) ChangePrivatePassphrase(ctx context.Context, oldPass, newPass string) error {
 	if oldPass == "" || newPass == "" {
 		return errors.New("Passphrase cannot be empty")
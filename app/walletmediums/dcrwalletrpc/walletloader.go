@@ -4,13 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"sort"
+
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/decred/dcrd/hdkeychain"
 	"github.com/decred/dcrwallet/rpc/walletrpc"
 	"github.com/decred/dcrwallet/walletseed"
 	"github.com/raedahgroup/dcrlibwallet"
 	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
+	"github.com/raedahgroup/godcr/app/logging"
 	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/app/walletmediums/walletlog"
+)
+
+var (
+	errSyncNotStarted = errors.New("blockchain sync has not been started")
+	errSyncCanceled   = errors.New("blockchain sync canceled")
 )
 
 func (c *WalletRPCClient) GenerateNewWalletSeed() (string, error) {
@@ -55,9 +65,7 @@ func (c *WalletRPCClient) IsWalletOpen() bool {
 	return c.walletOpen
 }
 
-func (c *WalletRPCClient) SyncBlockChain(showLog bool, syncProgressUpdated func(*defaultsynclistener.ProgressReport)) {
-	ctx := context.Background() // todo use a cancelable ctx
-
+func (c *WalletRPCClient) SyncBlockChain(ctx context.Context, showLog bool, syncProgressUpdated func(*defaultsynclistener.ProgressReport)) {
 	getBestBlock := func() int32 {
 		bestBlockHeight, _ := c.BestBlock()
 		return int32(bestBlockHeight)
@@ -76,15 +84,45 @@ func (c *WalletRPCClient) SyncBlockChain(showLog bool, syncProgressUpdated func(
 
 	// c.syncListener listens for reported sync updates, calculates progress and updates the caller via syncProgressUpdated
 	if c.syncListener == nil {
-		// use syncProgressUpdatedWrapper to suppress op parameter that's not needed by callers
+		logSyncStatus := walletlog.SyncStatusLogger(logMedium, c.syncOptions)
+
+		// use syncProgressUpdatedWrapper to log sync status changes and suppress op parameter that's not needed by callers
 		syncProgressUpdatedWrapper := func(progressReport *defaultsynclistener.ProgressReport, _ defaultsynclistener.SyncOp) {
+			logSyncStatus(progressReport)
 			syncProgressUpdated(progressReport)
 		}
 		c.syncListener = defaultsynclistener.DefaultSyncProgressListener(c.NetType(), showLog, getBestBlock, getBestBlockTimestamp,
 			syncProgressUpdatedWrapper)
 	}
 
-	syncStream, err := c.walletLoader.SpvSync(ctx, &walletrpc.SpvSyncRequest{})
+	c.syncMu.Lock()
+	c.syncCtx = ctx
+	c.syncShowLog = showLog
+	c.syncMu.Unlock()
+
+	c.startSync()
+}
+
+// startSync asks dcrwallet to sync the blockchain using the context set by the last SyncBlockChain call.
+// The sync streams are canceled if that context is canceled or CancelSync or RestartSync is called.
+func (c *WalletRPCClient) startSync() {
+	c.syncMu.Lock()
+	ctx, cancelSync := context.WithCancel(c.syncCtx)
+	c.cancelSync = cancelSync
+	showLog := c.syncShowLog
+	c.syncMu.Unlock()
+
+	c.clearConnectedPeers()
+	walletlog.LogSyncStarted(logMedium, c.syncOptions)
+
+	if c.syncOptions.UseDcrdRPC() {
+		c.rpcSync(ctx, showLog)
+		return
+	}
+
+	syncStream, err := c.walletLoader.SpvSync(ctx, &walletrpc.SpvSyncRequest{
+		SpvConnect: c.syncOptions.SPVConnect,
+	})
 	if err != nil {
 		c.syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
 		return
@@ -94,13 +132,18 @@ func (c *WalletRPCClient) SyncBlockChain(showLog bool, syncProgressUpdated func(
 	go func() {
 		for {
 			syncUpdate, err := syncStream.Recv()
+			if ctx.Err() != nil {
+				// sync stopped via CancelSync or RestartSync, or app is shutting down
+				return
+			}
 			if err != nil {
 				c.syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
 				c.syncListener.OnSynced(false)
+				c.streamEnded(ctx, syncStreamName, err)
 				return
 			}
 			if syncUpdate.Synced {
-				c.indexTransactions(ctx, -1, -1, showLog, func() {
+				c.indexTransactions(ctx, -1, -1, showLog, nil, func() {
 					c.syncListener.OnSynced(true)
 				})
 				return
@@ -133,38 +176,174 @@ func (c *WalletRPCClient) SyncBlockChain(showLog bool, syncProgressUpdated func(
 				c.syncListener.OnRescan(0, dcrlibwallet.SyncStateFinish)
 
 			case walletrpc.SyncNotificationType_PEER_CONNECTED:
-				c.numberOfPeers = syncUpdate.PeerInformation.PeerCount
+				c.setPeerConnected(syncUpdate.PeerInformation.Address, true, syncUpdate.PeerInformation.PeerCount)
 				c.syncListener.OnPeerConnected(syncUpdate.PeerInformation.PeerCount)
 
 			case walletrpc.SyncNotificationType_PEER_DISCONNECTED:
-				c.numberOfPeers = syncUpdate.PeerInformation.PeerCount
+				c.setPeerConnected(syncUpdate.PeerInformation.Address, false, syncUpdate.PeerInformation.PeerCount)
 				c.syncListener.OnPeerConnected(syncUpdate.PeerInformation.PeerCount)
 			}
 		}
 	}()
 }
 
-func (c *WalletRPCClient) RescanBlockChain() error {
+// rpcSync asks dcrwallet to sync the blockchain through the dcrd node set in config instead of spv peers.
+// dcrwallet only reports when the wallet is synced in this mode, the dcrd node is reported as the only connected peer.
+func (c *WalletRPCClient) rpcSync(ctx context.Context, showLog bool) {
+	cert, err := c.syncOptions.DcrdRPCCertificate()
+	if err != nil {
+		c.syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
+		return
+	}
+
+	syncStream, err := c.walletLoader.RpcSync(ctx, &walletrpc.RpcSyncRequest{
+		NetworkAddress: c.syncOptions.DcrdRPCServer,
+		Username:       c.syncOptions.DcrdRPCUser,
+		Password:       []byte(c.syncOptions.DcrdRPCPassword),
+		Certificate:    cert,
+	})
+	if err != nil {
+		c.syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
+		return
+	}
+
+	c.setPeerConnected(c.syncOptions.DcrdRPCServer, true, 1)
+	c.syncListener.OnPeerConnected(1)
+
+	go func() {
+		for {
+			syncUpdate, err := syncStream.Recv()
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				c.setPeerConnected(c.syncOptions.DcrdRPCServer, false, 0)
+				c.syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
+				c.syncListener.OnSynced(false)
+				c.streamEnded(ctx, syncStreamName, err)
+				return
+			}
+			if syncUpdate.Synced {
+				c.indexTransactions(ctx, -1, -1, showLog, nil, func() {
+					c.syncListener.OnSynced(true)
+				})
+				return
+			}
+		}
+	}()
+}
+
+func (c *WalletRPCClient) CancelSync() {
+	if c.stopSync() {
+		c.clearConnectedPeers()
+		c.syncListener.OnSyncError(dcrlibwallet.ErrorCodeContextCanceled, errSyncCanceled)
+	}
+}
+
+func (c *WalletRPCClient) RestartSync() error {
+	c.syncMu.Lock()
+	syncStarted := c.syncCtx != nil
+	c.syncMu.Unlock()
+
+	if !syncStarted {
+		return errSyncNotStarted
+	}
+
+	c.stopSync()
+	c.startSync()
+	return nil
+}
+
+// stopSync cancels the ongoing sync stream, returns false if there is no ongoing sync
+func (c *WalletRPCClient) stopSync() bool {
+	c.syncMu.Lock()
+	defer c.syncMu.Unlock()
+
+	if c.cancelSync == nil {
+		return false
+	}
+
+	c.cancelSync()
+	c.cancelSync = nil
+	return true
+}
+
+// setPeerConnected adds or removes `address` from the list of connected peers reported in WalletConnectionInfo
+// and sets the number of connected peers to `peerCount`
+func (c *WalletRPCClient) setPeerConnected(address string, connected bool, peerCount int32) {
+	c.peersMu.Lock()
+	defer c.peersMu.Unlock()
+
+	c.numberOfPeers = peerCount
+	if address == "" {
+		return
+	}
+	if connected {
+		c.connectedPeers[address] = struct{}{}
+	} else {
+		delete(c.connectedPeers, address)
+	}
+}
+
+func (c *WalletRPCClient) clearConnectedPeers() {
+	c.peersMu.Lock()
+	c.numberOfPeers = 0
+	c.connectedPeers = map[string]struct{}{}
+	c.peersMu.Unlock()
+}
+
+func (c *WalletRPCClient) connectedPeerCount() int32 {
+	c.peersMu.Lock()
+	defer c.peersMu.Unlock()
+	return c.numberOfPeers
+}
+
+func (c *WalletRPCClient) connectedPeerAddresses() []string {
+	c.peersMu.Lock()
+	defer c.peersMu.Unlock()
+
+	addresses := make([]string, 0, len(c.connectedPeers))
+	for address := range c.connectedPeers {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+func (c *WalletRPCClient) RescanBlockChain(fromHeight int32) error {
 	if c.syncListener == nil {
 		return fmt.Errorf("blockchain has not been synced previously")
 	}
 
-	rescanStream, err := c.walletService.Rescan(context.Background(), &walletrpc.RescanRequest{BeginHeight: 0})
+	bestBlock, err := c.BestBlock()
+	if err != nil {
+		return fmt.Errorf("error fetching best block: %s", err.Error())
+	}
+	if err = walletcore.ValidateRescanHeight(fromHeight, bestBlock); err != nil {
+		return err
+	}
+
+	rescanStream, err := c.walletService.Rescan(context.Background(), &walletrpc.RescanRequest{BeginHeight: fromHeight})
 	if err != nil {
 		return err
 	}
 
 	// notify rescan start
-	c.syncListener.OnRescan(0, dcrlibwallet.SyncStateStart)
+	c.syncListener.OnRescan(fromHeight, dcrlibwallet.SyncStateStart)
 
 	// read sync updates from rescanStream in goroutine and trigger c.syncListener methods to calculate progress and update caller
 	go func() {
 		for {
 			rescanResponse, err := rescanStream.Recv()
-			if err != nil {
+			if err == io.EOF {
 				c.syncListener.OnRescan(0, dcrlibwallet.SyncStateFinish)
 				return
 			}
+			if err != nil {
+				walletlog.Log.Error("Rescan failed", logging.Fields{"medium": logMedium, "error": err.Error()})
+				c.syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
+				return
+			}
 
 			// notify rescan progress
 			c.syncListener.OnRescan(rescanResponse.RescannedThrough, dcrlibwallet.SyncStateProgress)
@@ -202,7 +381,9 @@ func (c *WalletRPCClient) WalletConnectionInfo() (info walletcore.ConnectionInfo
 
 	info.LatestBlock = bestBlock
 	info.NetworkType = c.NetType()
-	info.PeersConnected = c.numberOfPeers
+	info.PeersConnected = c.connectedPeerCount()
+	info.PeerAddresses = c.connectedPeerAddresses()
+	info.WalletRPCState = c.connectionStateValue()
 
 	return
 }
@@ -222,6 +403,8 @@ func (c *WalletRPCClient) CloseWallet() {
 	// when they next launch godcr
 
 	// close tx index db though, so it can be re-opened next time
+	c.txIndexMu.Lock()
+	defer c.txIndexMu.Unlock()
 	if c.txIndexDB != nil {
 		err := c.txIndexDB.Close()
 		if err != nil {
@@ -0,0 +1,58 @@
+package walletlog
+
+import (
+	"sync"
+
+	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/logging"
+)
+
+// syncFields returns the fields that identify a blockchain sync by the wallet `medium` in log entries
+func syncFields(medium string, syncOptions config.SyncOptions) logging.Fields {
+	fields := logging.Fields{
+		"medium":    medium,
+		"sync_mode": syncOptions.Mode,
+	}
+	if syncOptions.UseDcrdRPC() {
+		fields["dcrd_rpc_server"] = syncOptions.DcrdRPCServer
+	} else if len(syncOptions.SPVConnect) > 0 {
+		fields["spv_connect"] = syncOptions.SPVConnect
+	}
+	return fields
+}
+
+// LogSyncStarted logs the start of a blockchain sync by the wallet `medium`
+func LogSyncStarted(medium string, syncOptions config.SyncOptions) {
+	Log.Info("Blockchain sync started", syncFields(medium, syncOptions))
+}
+
+// SyncStatusLogger returns a function that logs when the sync progress reports passed to it show that
+// the blockchain sync by the wallet `medium` failed or completed. Each change of status is only logged once.
+func SyncStatusLogger(medium string, syncOptions config.SyncOptions) func(report *defaultsynclistener.ProgressReport) {
+	var mu sync.Mutex
+	var lastStatus defaultsynclistener.SyncStatus
+
+	return func(report *defaultsynclistener.ProgressReport) {
+		progressReport := report.Read()
+
+		mu.Lock()
+		statusChanged := progressReport.Status != lastStatus
+		lastStatus = progressReport.Status
+		mu.Unlock()
+		if !statusChanged {
+			return
+		}
+
+		fields := syncFields(medium, syncOptions)
+		fields["connected_peers"] = progressReport.ConnectedPeers
+
+		switch progressReport.Status {
+		case defaultsynclistener.SyncStatusError:
+			fields["error"] = progressReport.Error
+			Log.Error("Blockchain sync failed", fields)
+		case defaultsynclistener.SyncStatusSuccess:
+			Log.Info("Blockchain synced", fields)
+		}
+	}
+}
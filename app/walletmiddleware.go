@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+
 	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
 	"github.com/raedahgroup/godcr/app/walletcore"
 )
@@ -16,12 +18,36 @@ type WalletMiddleware interface {
 
 	IsWalletOpen() bool
 
-	SyncBlockChain(showLog bool, syncProgressUpdated func(*defaultsynclistener.ProgressReport))
+	// SyncBlockChain starts syncing the blockchain in the background and reports sync progress via `syncProgressUpdated`.
+	// Sync is stopped when ctx is canceled or CancelSync is called.
+	SyncBlockChain(ctx context.Context, showLog bool, syncProgressUpdated func(*defaultsynclistener.ProgressReport))
+
+	// CancelSync stops an ongoing blockchain sync
+	CancelSync()
+
+	// RestartSync stops any ongoing blockchain sync and starts syncing again,
+	// using the context and progress callback passed to the last SyncBlockChain call
+	RestartSync() error
 
-	RescanBlockChain() error
+	// RescanBlockChain rescans the blockchain from `fromHeight` in the background for transactions involving this wallet.
+	// Rescan progress is reported via the progress callback passed to SyncBlockChain.
+	RescanBlockChain(fromHeight int32) error
 
 	WalletConnectionInfo() (info walletcore.ConnectionInfo, err error)
 
+	// SetChainReorgListener sets the function that is called after transactions mined in blocks detached by a chain reorganisation
+	// are updated in the tx index
+	SetChainReorgListener(onChainReorg func(reorg *walletcore.ChainReorg))
+
+	// CheckTxIndex compares the mined transactions in the tx index database with the wallet's transactions.
+	// Only supported with dcrwallet rpc, dcrlibwallet maintains its own tx index and returns an error.
+	CheckTxIndex(ctx context.Context) (*walletcore.TxIndexHealth, error)
+
+	// RebuildTxIndex drops the tx index database and indexes all the wallet's transactions again,
+	// reporting the block height indexed through via `indexProgress` if it is not nil.
+	// Only supported with dcrwallet rpc, dcrlibwallet maintains its own tx index and returns an error.
+	RebuildTxIndex(ctx context.Context, indexProgress func(indexedThrough, bestBlock int32)) error
+
 	// BestBlock fetches the best block on the network
 	BestBlock() (uint32, error)
 
@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// AccountXpubCommand displays the extended public key of an account in the wallet.
+type AccountXpubCommand struct {
+	commanderStub
+	Args AccountXpubArgs `positional-args:"yes"`
+}
+type AccountXpubArgs struct {
+	AccountName string `positional-arg-name:"account-name" default:"default"`
+}
+
+func (a AccountXpubCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	accountNumber, err := wallet.AccountNumber(a.Args.AccountName)
+	if err != nil {
+		return err
+	}
+
+	xpub, err := wallet.AccountExtendedPubKey(accountNumber)
+	if err != nil {
+		return err
+	}
+	termio.PrintStringResult(xpub)
+	return nil
+}
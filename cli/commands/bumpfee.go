@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// BumpFeeCommand increases the fee of an unmined transaction, or abandons it so that its inputs can be spent again.
+type BumpFeeCommand struct {
+	commanderStub
+	FeeRate float64     `long:"feerate" description:"New fee rate in DCR/kB, e.g. 0.0002. Must be higher than the transaction's current fee rate"`
+	Abandon bool        `long:"abandon" description:"Only abandon the transaction, freeing its inputs to be spent by a new transaction"`
+	Args    BumpFeeArgs `positional-args:"yes"`
+}
+type BumpFeeArgs struct {
+	TxHash string `positional-arg-name:"transaction hash" required:"yes"`
+}
+
+func (b BumpFeeCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	if b.Abandon {
+		if err := wallet.AbandonTransaction(ctx, b.Args.TxHash); err != nil {
+			return err
+		}
+		clilog.LogInfo("Transaction abandoned, its inputs can now be spent by a new transaction")
+		return nil
+	}
+
+	if b.FeeRate <= 0 {
+		return errors.New("specify the new fee rate with --feerate")
+	}
+	feeRate, err := dcrutil.NewAmount(b.FeeRate)
+	if err != nil {
+		return fmt.Errorf("invalid fee rate: %s", err.Error())
+	}
+
+	passphrase, err := getWalletPassphrase()
+	if err != nil {
+		return err
+	}
+
+	txHash, err := wallet.BumpTransactionFee(ctx, b.Args.TxHash, int64(feeRate), passphrase)
+	if err != nil {
+		return err
+	}
+
+	termio.PrintStringResult(fmt.Sprintf("Fee bumping (CPFP) transaction published. Hash: %s", txHash))
+	return nil
+}
@@ -8,14 +8,29 @@ import (
 
 // AvailableCommands defines thoroughly-tested commands and options available on the cli
 type AvailableCommands struct {
-	Balance         BalanceCommand         `command:"balance" description:"Show total balance for each account in wallet" long-description:"Also shows spendable balance if different from total balance"`
-	Send            SendCommand            `command:"send" description:"Send a transaction"`
-	Receive         ReceiveCommand         `command:"receive" description:"Show your address to receive funds"`
-	History         HistoryCommand         `command:"history" description:"Show your transaction history"`
-	ShowTransaction ShowTransactionCommand `command:"showtransaction" description:"Show details of a transaction"`
-	Help            HelpCommand            `command:"help" description:"Show general application help. Run help <command-name> to get help message for a specific command"`
-	StakeInfo       StakeInfoCommand       `command:"stakeinfo" description:"Show information about the wallet stakes, tickets and their statuses"`
-	PurchaseTicket  PurchaseTicketCommand  `command:"purchaseticket" description:"Purchase one or more tickets"`
+	Balance         BalanceCommand          `command:"balance" description:"Show total balance for each account in wallet" long-description:"Also shows spendable balance if different from total balance"`
+	Send            SendCommand             `command:"send" description:"Send a transaction"`
+	Receive         ReceiveCommand          `command:"receive" description:"Show your address to receive funds"`
+	History         HistoryCommand          `command:"history" description:"Show your transaction history"`
+	ShowTransaction ShowTransactionCommand  `command:"showtransaction" description:"Show details of a transaction"`
+	BumpFee         BumpFeeCommand          `command:"bumpfee" description:"Increase the fee of an unmined transaction, or abandon it" long-description:"Publishes a child transaction that spends the transaction's change with a higher fee (CPFP). Use --abandon to abandon the transaction instead"`
+	Unmined         UnminedCommand          `command:"unmined" subcommands-optional:"yes" description:"List, rebroadcast or abandon unmined transactions" long-description:"Run without a subcommand to list the wallet's unmined transactions, or run unmined rebroadcast or unmined abandon <transaction hash>"`
+	Peers           PeersCommand            `command:"peers" description:"Show the peers used to sync the blockchain" long-description:"Shows the spv peers set with spvconnect in config and the peers currently connected to. Run with --sync to see connected peers"`
+	Rescan          RescanCommand           `command:"rescan" description:"Rescan the blockchain for transactions involving the wallet" long-description:"Syncs the blockchain, then rescans it from --from-height (0 by default) and shows rescan progress until it completes"`
+	Reindex         ReindexCommand          `command:"reindex" description:"Rebuild the transaction index used to show transaction history (dcrwallet rpc only)" long-description:"Drops the tx index database and indexes all the wallet's transactions again. Run with --check to only compare the index with the wallet's transactions. Only available when connected to dcrwallet over rpc (walletrpcserver set in the config file), dcrlibwallet maintains its own tx index and cannot be checked or rebuilt"`
+	Help            HelpCommand             `command:"help" description:"Show general application help. Run help <command-name> to get help message for a specific command"`
+	StakeInfo       StakeInfoCommand        `command:"stakeinfo" description:"Show information about the wallet stakes, tickets and their statuses"`
+	PurchaseTicket  PurchaseTicketCommand   `command:"purchaseticket" description:"Purchase one or more tickets"`
+	RenameAccount   RenameAccountCommand    `command:"renameaccount" description:"Rename an account in the wallet"`
+	AccountXpub     AccountXpubCommand      `command:"accountxpub" description:"Show the extended public key (xpub) of an account" long-description:"Shows the xpub of the default account if no account name is provided"`
+	ImportPrivKey   ImportPrivateKeyCommand `command:"importprivkey" description:"Import a WIF-encoded private key into the wallet's imported account"`
+	ImportScript    ImportScriptCommand     `command:"importscript" description:"Import a hex-encoded redeem script into the wallet's imported account"`
+	Sweep           SweepCommand            `command:"sweep" description:"Send all funds controlled by a private key into a wallet account without importing the key"`
+	VoteChoices     VoteChoicesCommand      `command:"votechoices" description:"Show or set the wallet's vote choices for consensus agendas" long-description:"Run without arguments to list agendas and current vote choices, or run votechoices <agenda-id> <choice-id> to set a vote choice"`
+	SetupVSP        SetupVSPCommand         `command:"setupvsp" description:"Configure the voting service provider (stake pool) to use for ticket purchases" long-description:"Run without arguments to show the currently configured voting service provider"`
+	RPCSetup        RPCSetupCommand         `command:"rpcsetup" description:"Configure godcr to connect to a running dcrwallet daemon" long-description:"Reads the gRPC listen address, certificate and network from dcrwallet.conf, checks that dcrwallet can be reached and saves walletrpcserver, walletrpccert and nowalletrpctls to the godcr config file"`
+	RPCClientCert   RPCClientCertCommand    `command:"rpcclientcert" description:"Generate a client certificate for connecting to dcrwallet" long-description:"Generates a certificate and key that godcr presents to dcrwallet's gRPC server and saves them as walletrpcclientcert and walletrpcclientkey in the godcr config file. Set the certificate as clientcafile in dcrwallet.conf for dcrwallet to authenticate godcr"`
+	HTTPAuth        HTTPAuthCommand         `command:"httpauth" description:"Set the username and password or api tokens required to access godcr in http mode"`
 }
 
 // ExperimentalCommands defines experimental commands and options available on the cli
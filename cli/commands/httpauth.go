@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/cli/termio"
+	"github.com/raedahgroup/godcr/cli/termio/terminalprompt"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// HTTPAuthCommand sets the credentials required to access the web interface and api when running godcr in http mode.
+type HTTPAuthCommand struct {
+	commanderStub
+	GenerateToken bool `long:"generate-token" description:"Generate a new api token instead of setting a username and password"`
+	Remove        bool `long:"remove" description:"Remove the web interface username, password and all api tokens"`
+}
+
+// Run prompts for a username and password and saves the username and a bcrypt hash of the password to the config file.
+func (h HTTPAuthCommand) Run() error {
+	if h.Remove {
+		err := config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+			cnfg.HTTPUsername = ""
+			cnfg.HTTPPasswordHash = ""
+			cnfg.HTTPAPITokens = nil
+		})
+		if err != nil {
+			return err
+		}
+		termio.PrintStringResult("Web authentication removed")
+		return nil
+	}
+
+	if h.GenerateToken {
+		tokenBytes := make([]byte, 32)
+		if _, err := rand.Read(tokenBytes); err != nil {
+			return fmt.Errorf("error generating api token: %s", err.Error())
+		}
+		token := hex.EncodeToString(tokenBytes)
+
+		err := config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+			cnfg.HTTPAPITokens = append(cnfg.HTTPAPITokens, token)
+		})
+		if err != nil {
+			return err
+		}
+		termio.PrintStringResult(fmt.Sprintf("Api token: %s\nSend it in an 'Authorization: Bearer <token>' header", token))
+		return nil
+	}
+
+	username, err := terminalprompt.RequestInput("Username", terminalprompt.EmptyValidator)
+	if err != nil {
+		return fmt.Errorf("error receiving input: %s", err.Error())
+	}
+
+	password, err := terminalprompt.RequestInputSecure("Password", terminalprompt.EmptyValidator)
+	if err != nil {
+		return fmt.Errorf("error receiving input: %s", err.Error())
+	}
+
+	confirmPassword, err := terminalprompt.RequestInputSecure("Confirm password", terminalprompt.EmptyValidator)
+	if err != nil {
+		return fmt.Errorf("error receiving input: %s", err.Error())
+	}
+	if password != confirmPassword {
+		return errors.New("passwords do not match")
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("error hashing password: %s", err.Error())
+	}
+
+	err = config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+		cnfg.HTTPUsername = username
+		cnfg.HTTPPasswordHash = string(passwordHash)
+	})
+	if err != nil {
+		return err
+	}
+
+	termio.PrintStringResult("Web authentication set. Restart godcr in http mode for the change to take effect")
+	return nil
+}
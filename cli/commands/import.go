@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
+	"github.com/raedahgroup/godcr/cli/termio/terminalprompt"
+)
+
+// ImportPrivateKeyCommand imports a WIF-encoded private key into the wallet's imported account.
+type ImportPrivateKeyCommand struct {
+	commanderStub
+	NoRescan         bool                 `long:"no-rescan" description:"Do not rescan the blockchain for transactions involving the imported key"`
+	RescanFromHeight int32                `long:"rescan-from-height" default:"0" description:"Block height to begin rescanning from"`
+	Args             ImportPrivateKeyArgs `positional-args:"yes"`
+}
+type ImportPrivateKeyArgs struct {
+	PrivateKeyWif string `positional-arg-name:"private-key" description:"WIF-encoded private key. You will be prompted for the key if it is not provided"`
+}
+
+func (i ImportPrivateKeyCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	privateKeyWif := i.Args.PrivateKeyWif
+	if privateKeyWif == "" {
+		var err error
+		privateKeyWif, err = terminalprompt.RequestInputSecure("Private key (WIF)", terminalprompt.EmptyValidator)
+		if err != nil {
+			return fmt.Errorf("error receiving input: %s", err.Error())
+		}
+	}
+
+	passphrase, err := getWalletPassphrase()
+	if err != nil {
+		return err
+	}
+
+	err = wallet.ImportPrivateKey(ctx, privateKeyWif, passphrase, !i.NoRescan, i.RescanFromHeight)
+	if err != nil {
+		return err
+	}
+	clilog.LogInfo("Private key imported successfully")
+	return nil
+}
+
+// ImportScriptCommand imports a hex-encoded redeem script into the wallet's imported account.
+type ImportScriptCommand struct {
+	commanderStub
+	NoRescan         bool             `long:"no-rescan" description:"Do not rescan the blockchain for transactions involving the imported script"`
+	RescanFromHeight int32            `long:"rescan-from-height" default:"0" description:"Block height to begin rescanning from"`
+	Args             ImportScriptArgs `positional-args:"yes"`
+}
+type ImportScriptArgs struct {
+	ScriptHex string `positional-arg-name:"script" required:"yes" description:"Hex-encoded redeem script"`
+}
+
+func (i ImportScriptCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	passphrase, err := getWalletPassphrase()
+	if err != nil {
+		return err
+	}
+
+	err = wallet.ImportScript(ctx, i.Args.ScriptHex, passphrase, !i.NoRescan, i.RescanFromHeight)
+	if err != nil {
+		return err
+	}
+	clilog.LogInfo("Script imported successfully")
+	return nil
+}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// PeersCommand shows the peers the wallet is configured to sync with and the peers it is currently connected to.
+type PeersCommand struct {
+	commanderStub
+}
+
+// Run shows the spv peers set in config and the connected peers.
+// The cli only connects to peers while syncing, so connected peers are only shown when run with --sync.
+func (p PeersCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	cnfg, err := config.ReadConfigFile()
+	if err != nil {
+		return fmt.Errorf("error reading config file: %s", err.Error())
+	}
+
+	connectionInfoProvider, ok := wallet.(interface {
+		WalletConnectionInfo() (walletcore.ConnectionInfo, error)
+	})
+	if !ok {
+		return errors.New("connection info is not available for this wallet")
+	}
+	connectionInfo, err := connectionInfoProvider.WalletConnectionInfo()
+	if err != nil {
+		return err
+	}
+
+	var syncPeers string
+	switch {
+	case cnfg.SyncMode == config.SyncModeRPC:
+		syncPeers = fmt.Sprintf("dcrd at %s", cnfg.DcrdRPCServer)
+	case len(cnfg.SPVConnect) > 0:
+		syncPeers = strings.Join(cnfg.SPVConnect, ", ")
+	default:
+		syncPeers = "discovered automatically"
+	}
+
+	output := []string{
+		fmt.Sprintf("Sync peers \t %s", syncPeers),
+		fmt.Sprintf("Connected peers \t %d", connectionInfo.PeersConnected),
+	}
+	for _, address := range connectionInfo.PeerAddresses {
+		output = append(output, fmt.Sprintf("  \t %s", address))
+	}
+	if connectionInfo.WalletRPCState != "" {
+		output = append(output, fmt.Sprintf("dcrwallet connection \t %s", connectionInfo.WalletRPCState))
+	}
+	termio.PrintStringResult(output...)
+	return nil
+}
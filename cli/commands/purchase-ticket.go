@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app/vsp"
 	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
 	"github.com/raedahgroup/godcr/cli/termio"
 )
 
@@ -21,6 +23,7 @@ type PurchaseTicketCommand struct {
 	TxFee            int64   `long:"tx-fee" description:"Fees per kB to use for the transaction generating outputs to use for buying tickets." long-description:"If 0 is passed, the global value for a transaction fee will be used."`
 	TicketFee        int64   `long:"ticket-fee" description:"Fees per kB to use for all purchased tickets." long-description:"If 0 is passed, the global value for a ticket fee will be used."`
 	PayFrom          string  `long:"pay-from" description:"the account from which the funds will be spent to purchase the ticket" default:"default"`
+	NoVSP            bool    `long:"no-vsp" description:"Purchase tickets without using the voting service provider configured with setupvsp."`
 }
 
 func (ptc PurchaseTicketCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
@@ -35,7 +38,7 @@ func (ptc PurchaseTicketCommand) Run(ctx context.Context, wallet walletcore.Wall
 			return err
 		}
 	}
-	tickets, err := wallet.PurchaseTicket(ctx, dcrlibwallet.PurchaseTicketsRequest{
+	request := dcrlibwallet.PurchaseTicketsRequest{
 		TxFee:                 ptc.TxFee,
 		TicketFee:             ptc.TicketFee,
 		TicketAddress:         ptc.TicketAddress,
@@ -46,7 +49,21 @@ func (ptc PurchaseTicketCommand) Run(ctx context.Context, wallet walletcore.Wall
 		NumTickets:            ptc.NumTickets,
 		Expiry:                ptc.Expiry,
 		Account:               account,
-	})
+	}
+
+	// use the configured vsp if neither a pool address nor a ticket address was specified
+	if ptc.PoolAddress == "" && ptc.TicketAddress == "" && !ptc.NoVSP {
+		vspConfig, err := vsp.ReadConfig()
+		if err != nil {
+			return err
+		}
+		if vspConfig != nil {
+			vspConfig.ApplyToPurchaseRequest(&request)
+			clilog.LogInfo(fmt.Sprintf("Purchasing ticket(s) through voting service provider %s", vspConfig.Host()))
+		}
+	}
+
+	tickets, err := wallet.PurchaseTicket(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// ReindexCommand checks the tx index against the wallet's transactions and rebuilds it.
+// Only wallets connected to dcrwallet over rpc have a tx index that godcr manages, dcrlibwallet maintains its own.
+type ReindexCommand struct {
+	commanderStub
+	Check bool `long:"check" description:"Only check that the tx index has the same transactions as the wallet, without rebuilding it"`
+}
+
+// Run rebuilds the tx index, printing the block height indexed through, or only checks the index if --check is set.
+func (r ReindexCommand) Run(ctx context.Context, walletMiddleware app.WalletMiddleware) error {
+	if r.Check {
+		health, err := walletMiddleware.CheckTxIndex(ctx)
+		if err != nil {
+			return err
+		}
+
+		status := "healthy"
+		if !health.Healthy() {
+			status = "out of sync with wallet, run godcr reindex to rebuild it"
+		}
+		output := []string{
+			fmt.Sprintf("Tx index \t %s", status),
+			fmt.Sprintf("Checked blocks \t 0 - %d", health.CheckedToHeight-1),
+			fmt.Sprintf("Indexed transactions \t %d", health.IndexedCount),
+			fmt.Sprintf("Wallet transactions \t %d", health.WalletCount),
+		}
+		for _, hash := range health.MissingTxHashes {
+			output = append(output, fmt.Sprintf("Missing from index \t %s", hash))
+		}
+		for _, hash := range health.UnknownTxHashes {
+			output = append(output, fmt.Sprintf("Unknown to wallet \t %s", hash))
+		}
+		termio.PrintStringResult(output...)
+		return nil
+	}
+
+	fmt.Println("Rebuilding tx index.")
+	err := walletMiddleware.RebuildTxIndex(ctx, func(indexedThrough, bestBlock int32) {
+		fmt.Printf("Indexed through block %d of %d\n", indexedThrough, bestBlock)
+	})
+	if err != nil {
+		return fmt.Errorf("error rebuilding tx index: %s", err.Error())
+	}
+
+	count, err := walletMiddleware.TransactionCount(nil)
+	if err != nil {
+		return fmt.Errorf("tx index rebuilt, error counting indexed transactions: %s", err.Error())
+	}
+	fmt.Printf("Tx index rebuilt, %d transaction(s) indexed.\n", count)
+	return nil
+}
@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
+)
+
+// RenameAccountCommand changes the name of an existing account in the wallet.
+type RenameAccountCommand struct {
+	commanderStub
+	Args RenameAccountArgs `positional-args:"yes"`
+}
+type RenameAccountArgs struct {
+	AccountName string `positional-arg-name:"account-name" required:"yes"`
+	NewName     string `positional-arg-name:"new-name" required:"yes"`
+}
+
+func (r RenameAccountCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	accountNumber, err := wallet.AccountNumber(r.Args.AccountName)
+	if err != nil {
+		return err
+	}
+
+	err = wallet.RenameAccount(accountNumber, r.Args.NewName)
+	if err != nil {
+		return err
+	}
+	clilog.LogInfo("Account renamed successfully")
+	return nil
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
+	"github.com/raedahgroup/godcr/app"
+)
+
+// RescanCommand rescans the blockchain for transactions involving the wallet.
+type RescanCommand struct {
+	commanderStub
+	FromHeight int32 `long:"from-height" default:"0" description:"Block height to begin rescanning from"`
+}
+
+// Run syncs the blockchain and rescans it from the specified height, printing rescan progress until the rescan completes.
+// The blockchain is always synced before rescanning so the --sync option is not required.
+func (r RescanCommand) Run(ctx context.Context, walletMiddleware app.WalletMiddleware) error {
+	// sync updates are received on the sync listener's goroutine(s),
+	// state shared with Run is only passed through these channels or updated atomically
+	syncDone := make(chan error, 1)
+	rescanStarted := make(chan struct{})
+	rescanDone := make(chan struct{})
+	var syncDoneOnce, rescanDoneOnce sync.Once
+	var lastRescanHeight int32
+
+	processSyncUpdates := func(report *defaultsynclistener.ProgressReport) {
+		progressReport := report.Read()
+
+		select {
+		case <-rescanStarted:
+		default:
+			if progressReport.Done {
+				syncDoneOnce.Do(func() {
+					if progressReport.Error != "" {
+						syncDone <- fmt.Errorf(progressReport.Error)
+					} else {
+						syncDone <- nil
+					}
+				})
+			}
+			return
+		}
+
+		if progressReport.RescanProgress >= 100 {
+			rescanDoneOnce.Do(func() {
+				close(rescanDone)
+			})
+			return
+		}
+
+		for {
+			lastHeight := atomic.LoadInt32(&lastRescanHeight)
+			if progressReport.CurrentRescanHeight <= lastHeight {
+				return
+			}
+			if atomic.CompareAndSwapInt32(&lastRescanHeight, lastHeight, progressReport.CurrentRescanHeight) {
+				fmt.Printf("Rescanning block %d of %d (%d%%)\n", progressReport.CurrentRescanHeight,
+					progressReport.TotalHeadersToFetch, progressReport.RescanProgress)
+				return
+			}
+		}
+	}
+
+	fmt.Println("Syncing blockchain before rescan.")
+	walletMiddleware.SyncBlockChain(ctx, false, processSyncUpdates)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-syncDone:
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Sync completed with error: %s.\n", err.Error())
+			return err
+		}
+	}
+
+	close(rescanStarted)
+	if err := walletMiddleware.RescanBlockChain(r.FromHeight); err != nil {
+		return fmt.Errorf("error starting rescan: %s", err.Error())
+	}
+	fmt.Printf("Rescan started from block %d.\n", r.FromHeight)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-rescanDone:
+		fmt.Println("Rescan completed.")
+		return nil
+	}
+}
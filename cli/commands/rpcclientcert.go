@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/walletmediums/dcrwalletrpc"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// RPCClientCertCommand generates a client certificate for connecting to a dcrwallet daemon that authenticates clients.
+type RPCClientCertCommand struct {
+	commanderStub
+	CertFile  string `long:"cert" description:"Path to save the client certificate to. Defaults to rpc-client.cert in the godcr data directory"`
+	KeyFile   string `long:"key" description:"Path to save the client key to. Defaults to rpc-client.key in the godcr data directory"`
+	Overwrite bool   `long:"overwrite" description:"Replace the certificate and key files if they exist"`
+}
+
+// Run generates the client certificate and key and saves their paths to the godcr config file.
+func (r RPCClientCertCommand) Run() error {
+	cnfg, err := config.ReadConfigFile()
+	if err != nil {
+		return fmt.Errorf("error reading config file: %s", err.Error())
+	}
+
+	certFile, keyFile := r.CertFile, r.KeyFile
+	if certFile == "" {
+		certFile = config.DefaultRPCClientCertFile(cnfg.AppDataDir)
+	}
+	if keyFile == "" {
+		keyFile = config.DefaultRPCClientKeyFile(cnfg.AppDataDir)
+	}
+
+	if err := dcrwalletrpc.GenerateClientCertificate(certFile, keyFile, r.Overwrite); err != nil {
+		return err
+	}
+
+	err = config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+		cnfg.WalletRPCClientCert = certFile
+		cnfg.WalletRPCClientKey = keyFile
+	})
+	if err != nil {
+		return fmt.Errorf("error saving client certificate paths: %s", err.Error())
+	}
+
+	termio.PrintStringResult(
+		fmt.Sprintf("Client certificate \t %s", certFile),
+		fmt.Sprintf("Client key \t %s", keyFile),
+		"",
+		fmt.Sprintf("Set clientcafile=%s in dcrwallet.conf and restart dcrwallet to only accept connections from godcr", certFile),
+	)
+	return nil
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/walletmediums/dcrwalletrpc"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// RPCSetupCommand configures godcr to connect to a running dcrwallet daemon using the settings in dcrwallet's config file.
+type RPCSetupCommand struct {
+	commanderStub
+	WalletConfigFile string `long:"dcrwalletconf" description:"Path to the dcrwallet config file. Defaults to dcrwallet.conf in the default dcrwallet data directory"`
+}
+
+// Run reads dcrwallet's gRPC settings, checks that dcrwallet can be reached with them
+// and saves them to the godcr config file.
+func (r RPCSetupCommand) Run() error {
+	cnfg, err := config.ReadConfigFile()
+	if err != nil {
+		return fmt.Errorf("error reading config file: %s", err.Error())
+	}
+
+	settings, err := dcrwalletrpc.DiscoverRPCSettings(context.Background(), r.WalletConfigFile,
+		cnfg.WalletRPCClientCert, cnfg.WalletRPCClientKey)
+	if err != nil {
+		return err
+	}
+
+	err = config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+		cnfg.WalletRPCServer = settings.Server
+		cnfg.WalletRPCCert = settings.Cert
+		cnfg.NoWalletRPCTLS = settings.NoTLS
+	})
+	if err != nil {
+		return fmt.Errorf("error saving dcrwallet rpc settings: %s", err.Error())
+	}
+
+	tls := "enabled"
+	if settings.NoTLS {
+		tls = "disabled"
+	} else if settings.ClientAuthRequired {
+		tls = "enabled, with client certificate"
+	}
+	termio.PrintStringResult(
+		fmt.Sprintf("dcrwallet rpc server \t %s", settings.Server),
+		fmt.Sprintf("Certificate \t %s", settings.Cert),
+		fmt.Sprintf("TLS \t %s", tls),
+		fmt.Sprintf("Network \t %s", settings.Network),
+		"",
+		"Settings saved, godcr will connect to the wallet through dcrwallet from now on",
+	)
+	return nil
+}
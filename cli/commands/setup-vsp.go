@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/vsp"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// SetupVSPCommand configures the voting service provider (stake pool) used when purchasing tickets.
+type SetupVSPCommand struct {
+	commanderStub
+	Remove bool                `long:"remove" description:"Remove the previously configured voting service provider"`
+	Args   SetupVSPCommandArgs `positional-args:"yes"`
+}
+
+type SetupVSPCommandArgs struct {
+	APIURL string `positional-arg-name:"api-url" description:"API URL of the voting service provider e.g. https://stakepool.example.com"`
+	APIKey string `positional-arg-name:"api-key" description:"API key obtained from the voting service provider's website"`
+}
+
+// Run fetches the purchase info for the provided api key from the vsp, imports the vsp's ticket script into the wallet
+// and saves the purchase info to the config file.
+// If no api url is provided, the currently configured vsp is displayed.
+func (s SetupVSPCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	if s.Remove {
+		if err := vsp.SaveConfig(nil, nil); err != nil {
+			return err
+		}
+		termio.PrintStringResult("Voting service provider removed")
+		return nil
+	}
+
+	if s.Args.APIURL == "" {
+		vspConfig, err := vsp.ReadConfig()
+		if err != nil {
+			return err
+		}
+		if vspConfig == nil {
+			termio.PrintStringResult("No voting service provider configured")
+			return nil
+		}
+		printVSPConfig(vspConfig)
+		return nil
+	}
+
+	if s.Args.APIKey == "" {
+		return errors.New("the vsp api key is required")
+	}
+
+	// the vsp's ticket script is imported into the wallet, which requires the wallet passphrase
+	passphrase, err := getWalletPassphrase()
+	if err != nil {
+		return err
+	}
+
+	importScript := func(scriptHex string) error {
+		return wallet.ImportScript(ctx, scriptHex, passphrase, false, 0)
+	}
+	vspConfig, err := vsp.Setup(ctx, s.Args.APIURL, s.Args.APIKey, wallet.ValidateAddress, importScript)
+	if err != nil {
+		return err
+	}
+
+	if err = vsp.SaveConfig(vspConfig, nil); err != nil {
+		return err
+	}
+
+	printVSPConfig(vspConfig)
+	return nil
+}
+
+func printVSPConfig(vspConfig *vsp.Config) {
+	output := fmt.Sprintf("Voting service provider \t %s\n"+
+		"Pool address \t %s\n"+
+		"Pool fees \t %.2f%%\n"+
+		"Ticket address \t %s",
+		vspConfig.APIURL, vspConfig.PoolAddress, vspConfig.PoolFees, vspConfig.TicketAddress)
+	termio.PrintStringResult(output)
+}
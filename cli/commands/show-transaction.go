@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/decred/dcrd/dcrutil"
+	"github.com/raedahgroup/godcr/app/config"
 	godcrUtils "github.com/raedahgroup/godcr/app/utils"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/cli/termio"
@@ -15,7 +16,8 @@ import (
 // ShowTransactionCommand requests for transaction details with a transaction hash.
 type ShowTransactionCommand struct {
 	commanderStub
-	Args ShowTransactionCommandArgs `positional-args:"yes"`
+	ShowRawHex bool                       `long:"raw" description:"Also display the raw transaction hex"`
+	Args       ShowTransactionCommandArgs `positional-args:"yes"`
 	*historyCommandData
 }
 
@@ -76,20 +78,29 @@ func (showTxCommand ShowTransactionCommand) Run(ctx context.Context, wallet wall
 	txDetailsOutput := strings.Builder{}
 	txDetailsOutput.WriteString("Transaction Details\n")
 	txDetailsOutput.WriteString(basicOutput)
+	if explorerURL := explorerTxURL(wallet.NetType(), transaction.Hash); explorerURL != "" {
+		txDetailsOutput.WriteString(fmt.Sprintf("  Explorer \t %s\n", explorerURL))
+	}
 	txDetailsOutput.WriteString("-Inputs- \t \n")
 	for _, input := range transaction.Inputs {
 		inputAmount := formatAmount(input.Amount)
-		txDetailsOutput.WriteString(fmt.Sprintf("  %s \t %s\n", inputAmount, input.PreviousOutpoint))
+		txDetailsOutput.WriteString(fmt.Sprintf("  %s \t %s (%s)\n", inputAmount, input.PreviousOutpoint,
+			walletcore.TxAccountName(input.AccountNumber, input.AccountName)))
 	}
 	txDetailsOutput.WriteString("-Outputs- \t \n") // add tabs to maintain tab spacing for previous inputs section and next outputs section
 	for _, out := range transaction.Outputs {
 		outputAmount := formatAmount(out.Amount)
+		accountName := walletcore.TxAccountName(out.AccountNumber, out.AccountName)
 
 		if out.Address == "" {
-			txDetailsOutput.WriteString(fmt.Sprintf("  %s \t (no address)\n", outputAmount))
+			txDetailsOutput.WriteString(fmt.Sprintf("  %s \t (no address) %s (%s)\n", outputAmount, out.ScriptType, accountName))
 			continue
 		}
-		txDetailsOutput.WriteString(fmt.Sprintf("  %s \t %s (%s)\n", outputAmount, out.Address, out.AccountName))
+		txDetailsOutput.WriteString(fmt.Sprintf("  %s \t %s %s (%s)\n", outputAmount, out.Address, out.ScriptType, accountName))
+	}
+	if showTxCommand.ShowRawHex {
+		txDetailsOutput.WriteString("-Raw Transaction- \t \n")
+		txDetailsOutput.WriteString(fmt.Sprintf("  %s \t \n", transaction.Hex))
 	}
 	termio.PrintStringResult(strings.TrimRight(txDetailsOutput.String(), " \n\r"))
 
@@ -128,3 +139,13 @@ func (showTxCommand ShowTransactionCommand) Run(ctx context.Context, wallet wall
 
 	return nil
 }
+
+// explorerTxURL returns the block explorer link for the transaction as set in the config file.
+// Returns an empty string if the config file cannot be read or no link is set for the network.
+func explorerTxURL(netType, txHash string) string {
+	cnfg, err := config.ReadConfigFile()
+	if err != nil {
+		return ""
+	}
+	return cnfg.Settings.ExplorerTxURL(netType, txHash)
+}
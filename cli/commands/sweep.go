@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/sweep"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/termio"
+	"github.com/raedahgroup/godcr/cli/termio/terminalprompt"
+)
+
+// SweepCommand sends all funds controlled by a private key into a wallet account without importing the key.
+type SweepCommand struct {
+	commanderStub
+	Args SweepCommandArgs `positional-args:"yes"`
+}
+type SweepCommandArgs struct {
+	AccountName string `positional-arg-name:"account-name" description:"The name of the account to sweep funds into"`
+}
+
+// Run prompts for the private key to sweep and sends all its confirmed unspent outputs into the selected account.
+// The key's address is only sent to the lookup link set with sweeputxourl after the user confirms it.
+func (s SweepCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	var accountNumber uint32
+	var err error
+	if s.Args.AccountName == "" {
+		accountNumber, err = selectAccount(wallet)
+	} else {
+		accountNumber, err = wallet.AccountNumber(s.Args.AccountName)
+	}
+	if err != nil {
+		return err
+	}
+
+	cnfg, err := config.ReadConfigFile()
+	if err != nil {
+		return fmt.Errorf("error reading config file: %s", err.Error())
+	}
+	utxoService := cnfg.Settings.SweepUTXOService(wallet.NetType())
+	if utxoService == "" {
+		return fmt.Errorf("no link is set to look up unspent outputs on %s, set sweeputxourl in the config file to sweep private keys",
+			wallet.NetType())
+	}
+
+	// the wallet cannot find outputs for addresses that are not its own, make sure the user is okay with the address being sent out
+	confirmed, err := terminalprompt.RequestYesNoConfirmation(fmt.Sprintf("The address of the private key will be sent to %s "+
+		"to find its unspent outputs. Continue?", utxoService), "n")
+	if err != nil {
+		return fmt.Errorf("error receiving input: %s", err.Error())
+	}
+	if !confirmed {
+		return nil
+	}
+
+	privateKeyWif, err := terminalprompt.RequestInputSecure("Private key to sweep (WIF)", terminalprompt.EmptyValidator)
+	if err != nil {
+		return fmt.Errorf("error receiving input: %s", err.Error())
+	}
+
+	result, err := sweep.Sweep(ctx, wallet, privateKeyWif, accountNumber, func(address string) string {
+		return cnfg.Settings.SweepUTXOURL(wallet.NetType(), address)
+	})
+	if err != nil {
+		return err
+	}
+
+	termio.PrintStringResult(fmt.Sprintf("Swept %s from %s (%d outputs)\nTransaction hash: %s",
+		result.TotalAmount, result.SourceAddress, result.NumInputs, result.TransactionHash))
+	return nil
+}
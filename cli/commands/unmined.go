@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// UnminedCommand lists the wallet's unmined transactions. Its subcommands rebroadcast or abandon unmined transactions.
+type UnminedCommand struct {
+	commanderStub
+	Rebroadcast UnminedRebroadcastCommand `command:"rebroadcast" description:"Publish all unmined transactions to connected peers again"`
+	Abandon     UnminedAbandonCommand     `command:"abandon" description:"Abandon an unmined transaction so that its inputs can be spent by a new transaction"`
+}
+
+// Run lists the wallet's unmined transactions when no subcommand is specified.
+func (u UnminedCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	unminedTxs, err := wallet.UnminedTransactions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(unminedTxs) == 0 {
+		termio.PrintStringResult("No unmined transactions")
+		return nil
+	}
+
+	columns := []string{
+		"Date",
+		"Direction",
+		centerAlignAmountHeader("Amount"),
+		centerAlignAmountHeader("Fee"),
+		"Type",
+		"Hash",
+	}
+	rows := make([][]interface{}, len(unminedTxs))
+	for i, tx := range unminedTxs {
+		rows[i] = []interface{}{
+			tx.ShortTime,
+			tx.Direction,
+			formatAmount(tx.Amount),
+			formatFee(tx.Fee),
+			tx.Type,
+			tx.Hash,
+		}
+	}
+	termio.PrintTabularResult(termio.StdoutWriter, columns, rows)
+	return nil
+}
+
+// UnminedRebroadcastCommand publishes the wallet's unmined transactions to connected peers again.
+type UnminedRebroadcastCommand struct {
+	commanderStub
+}
+
+func (u UnminedRebroadcastCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	if err := wallet.RebroadcastUnminedTransactions(ctx); err != nil {
+		return err
+	}
+	clilog.LogInfo("Unmined transactions rebroadcast")
+	return nil
+}
+
+// UnminedAbandonCommand abandons an unmined transaction.
+type UnminedAbandonCommand struct {
+	commanderStub
+	Args UnminedAbandonArgs `positional-args:"yes"`
+}
+type UnminedAbandonArgs struct {
+	TxHash string `positional-arg-name:"transaction hash" required:"yes"`
+}
+
+func (u UnminedAbandonCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	if err := wallet.AbandonTransaction(ctx, u.Args.TxHash); err != nil {
+		return err
+	}
+	clilog.LogInfo(fmt.Sprintf("Transaction %s abandoned, its inputs can now be spent by a new transaction", u.Args.TxHash))
+	return nil
+}
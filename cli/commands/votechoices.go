@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// VoteChoicesCommand displays or sets the choices the wallet's tickets vote for on consensus agendas.
+type VoteChoicesCommand struct {
+	commanderStub
+	Args VoteChoicesCommandArgs `positional-args:"yes"`
+}
+
+type VoteChoicesCommandArgs struct {
+	AgendaID string `positional-arg-name:"agenda-id" description:"ID of the agenda to set a vote choice for"`
+	ChoiceID string `positional-arg-name:"choice-id" description:"ID of the choice to vote for on the agenda"`
+}
+
+// Run lists the agendas for the wallet's vote version and the current vote choice for each agenda.
+// If an agenda id and choice id are provided, the vote choice for that agenda is updated instead.
+func (v VoteChoicesCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	agendas, err := wallet.VoteChoices(ctx)
+	if err != nil {
+		return err
+	}
+
+	if v.Args.AgendaID == "" {
+		if len(agendas) == 0 {
+			termio.PrintStringResult("There are no agendas to vote on")
+			return nil
+		}
+
+		columns := []string{"Agenda", "Description", "Choices", "Vote Choice"}
+		rows := make([][]interface{}, len(agendas))
+		for i, agenda := range agendas {
+			choiceIDs := make([]string, len(agenda.Choices))
+			for j, choice := range agenda.Choices {
+				choiceIDs[j] = choice.ID
+			}
+			rows[i] = []interface{}{agenda.ID, agenda.Description, strings.Join(choiceIDs, ", "), agenda.VoteChoice}
+		}
+		termio.PrintTabularResult(termio.StdoutWriter, columns, rows)
+		return nil
+	}
+
+	if v.Args.ChoiceID == "" {
+		return fmt.Errorf("specify the choice to vote for on agenda %s", v.Args.AgendaID)
+	}
+
+	agenda := findAgenda(agendas, v.Args.AgendaID)
+	if agenda == nil {
+		return fmt.Errorf("agenda %s not found", v.Args.AgendaID)
+	}
+
+	choiceIsValid := false
+	choiceIDs := make([]string, len(agenda.Choices))
+	for i, choice := range agenda.Choices {
+		choiceIDs[i] = choice.ID
+		if choice.ID == v.Args.ChoiceID {
+			choiceIsValid = true
+		}
+	}
+	if !choiceIsValid {
+		return fmt.Errorf("invalid choice %s for agenda %s, valid choices are: %s", v.Args.ChoiceID, agenda.ID,
+			strings.Join(choiceIDs, ", "))
+	}
+
+	if err = wallet.SetVoteChoice(ctx, agenda.ID, v.Args.ChoiceID); err != nil {
+		return err
+	}
+
+	termio.PrintStringResult(fmt.Sprintf("Vote choice for agenda %s set to %s", agenda.ID, v.Args.ChoiceID))
+	return nil
+}
+
+func findAgenda(agendas []*walletcore.Agenda, agendaID string) *walletcore.Agenda {
+	for _, agenda := range agendas {
+		if agenda.ID == agendaID {
+			return agenda
+		}
+	}
+	return nil
+}
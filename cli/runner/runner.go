@@ -66,6 +66,11 @@ func (runner *CommandRunner) RunNoneWalletCommands(command flags.Commander, args
 		return commandRunner.Run(runner.ctx)
 	}
 
+	// run commands implementing NoneWalletCommandRunner without any dependency
+	if commandRunner, ok := command.(NoneWalletCommandRunner); ok {
+		return commandRunner.Run()
+	}
+
 	// execute other commands directly
 	return command.Execute(args)
 }
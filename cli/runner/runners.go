@@ -28,6 +28,13 @@ type WalletMiddlewareCommandRunner interface {
 	flags.Commander
 }
 
+// NoneWalletCommandRunner defines the Run method for cli commands that do not require access to a decred wallet
+// or any other dependency at execution time
+type NoneWalletCommandRunner interface {
+	Run() error
+	flags.Commander
+}
+
 // ParserCommandRunner defines the Run method that cli commands that depends on
 // flags.Parser can implement to have it injected at run time
 type ParserCommandRunner interface {
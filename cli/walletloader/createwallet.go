@@ -112,7 +112,7 @@ func prepareMiddlewareToCreateNewWallet(ctx context.Context, newWalletNetwork st
 		networkDir = fmt.Sprintf("%s-%d", newWalletNetwork, networkDirSuffix)
 	}
 
-	return dcrlibwallet.Connect(ctx, walletDbDir, newWalletNetwork)
+	return dcrlibwallet.Connect(ctx, walletDbDir, newWalletNetwork, cfg.SyncOptions())
 }
 
 // requestNewWalletPassphrase asks user to enter private passphrase for new wallet twice.
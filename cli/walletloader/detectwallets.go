@@ -147,7 +147,7 @@ func listWalletsForSelection(ctx context.Context, cfg *config.Config, allDetecte
 		if len(allDetectedWallets) == 1 {
 			promptToSaveDefaultWallet(selectedWallet.DbDir)
 		}
-		return dcrlibwallet.Connect(ctx, selectedWallet.DbDir, selectedWallet.Network)
+		return dcrlibwallet.Connect(ctx, selectedWallet.DbDir, selectedWallet.Network, cfg.SyncOptions())
 	}
 
 	// user chose to create new wallet
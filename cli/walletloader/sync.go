@@ -37,7 +37,7 @@ func SyncBlockChain(ctx context.Context, walletMiddleware app.WalletMiddleware)
 	}
 
 	fmt.Println("Sync started.")
-	walletMiddleware.SyncBlockChain(true, processSyncUpdates)
+	walletMiddleware.SyncBlockChain(ctx, true, processSyncUpdates)
 
 	// wait for context cancel or sync done trigger before exiting function
 	select {
@@ -48,7 +48,7 @@ func (app *fyneApp) showSyncWindow() {
 	app.mainWindow.Show()
 
 	var isFirstSyncCompleted = true
-	app.walletMiddleware.SyncBlockChain(false, func(report *defaultsynclistener.ProgressReport) {
+	app.walletMiddleware.SyncBlockChain(app.ctx, false, func(report *defaultsynclistener.ProgressReport) {
 		progressReport := report.Read()
 
 		progressBar.SetValue(float64(progressReport.TotalSyncProgress))
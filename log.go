@@ -9,11 +9,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"sort"
-	"strings"
 
 	"github.com/decred/slog"
 	"github.com/jrick/logrotate/rotator"
+	"github.com/raedahgroup/godcr/app/logging"
+	"github.com/raedahgroup/godcr/app/walletmediums/walletlog"
 	"github.com/raedahgroup/godcr/cli/clilog"
 	fyneLog "github.com/raedahgroup/godcr/fyne/log"
 	"github.com/raedahgroup/godcr/nuklear/nuklog"
@@ -29,49 +29,48 @@ func (logWriter) Write(p []byte) (n int, err error) {
 	return logRotator.Write(p)
 }
 
-// Loggers per subsystem.  A single backend logger is created and all subsytem
-// loggers created from it will write to the backend.  When adding new
-// subsystems, add the subsystem logger variable here and to the
-// subsystemLoggers map.
+// Loggers per subsystem.  All subsystem loggers are created from a single
+// backend and write to the log rotator.  When adding new subsystems, add the
+// subsystem logger to initLoggers.
 //
 // Loggers can not be used before the log rotator has been initialized with a
-// log file.  This must be performed early during application startup by calling
-// initLogRotator.
+// log file and initLoggers has been called.  This must be performed early
+// during application startup.
 var (
-	// backendLog is the logging backend used to create all subsystem loggers.
-	// The backend must not be used before the log rotator has been initialized,
-	// or data races and/or nil pointer dereferences will occur.
-	backendLog = slog.NewBackend(logWriter{})
-
 	// logRotator is one of the logging outputs.  It should be closed on
 	// application shutdown.
 	logRotator *rotator.Rotator
 
-	log        = backendLog.Logger("GODC")
-	webLog     = backendLog.Logger("WEB")
-	nuklearLog = backendLog.Logger("NUK")
-	cliLog     = backendLog.Logger("CLI")
-	terLog     = backendLog.Logger("TER")
-	fyneLogger = backendLog.Logger("FYN")
+	log = slog.Disabled
 )
 
-// Initialize package-global logger variables.
-func init() {
-	clilog.UseLogger(cliLog)
-	nuklog.UseLogger(nuklearLog)
-	weblog.UseLogger(webLog)
-	terlog.UseLogger(terLog)
-	fyneLog.UseLogger(fyneLogger)
-}
+// initLoggers creates the subsystem loggers, writing entries in the text or json `logFormat`,
+// and sets them as the loggers of each subsystem package. The log level of each subsystem is info
+// until changed with logging.SetLevel.
+func initLoggers(logFormat string) {
+	var newLogger func(subsystemID string) slog.Logger
+	if logFormat == logging.FormatJSON {
+		newLogger = logging.NewJSONBackend(logWriter{}).Logger
+	} else {
+		backendLog := slog.NewBackend(logWriter{})
+		newLogger = func(subsystemID string) slog.Logger {
+			return logging.NewTextLogger(backendLog.Logger(subsystemID))
+		}
+	}
 
-// subsystemLoggers maps each subsystem identifier to its associated logger.
-var subsystemLoggers = map[string]slog.Logger{
-	"GODC": log,
-	"WEB":  webLog,
-	"NUK":  nuklearLog,
-	"CLI":  cliLog,
-	"TER":  terLog,
-	"FYN":  fyneLogger,
+	subsystemLogger := func(subsystemID string) slog.Logger {
+		logger := newLogger(subsystemID)
+		logging.RegisterSubsystem(subsystemID, logger)
+		return logger
+	}
+
+	log = subsystemLogger("GODC")
+	clilog.UseLogger(subsystemLogger("CLI"))
+	nuklog.UseLogger(subsystemLogger("NUK"))
+	weblog.UseLogger(subsystemLogger("WEB"))
+	terlog.UseLogger(subsystemLogger("TER"))
+	fyneLog.UseLogger(subsystemLogger("FYN"))
+	walletlog.UseLogger(subsystemLogger("WLLT"))
 }
 
 // initLogRotator initializes the logging rotater to write logs to logFile and
@@ -93,103 +92,6 @@ func initLogRotator(logFile string) {
 	logRotator = r
 }
 
-// setLogLevel sets the logging level for provided subsystem.  Invalid
-// subsystems are ignored.  Uninitialized subsystems are dynamically created as
-// needed.
-func setLogLevel(subsystemID string, logLevel string) {
-	// Ignore invalid subsystems.
-	logger, ok := subsystemLoggers[subsystemID]
-	if !ok {
-		return
-	}
-
-	// Defaults to info if the log level is invalid.
-	level, _ := slog.LevelFromString(logLevel)
-	logger.SetLevel(level)
-}
-
-// setLogLevels sets the log level for all subsystem loggers to the passed
-// level.  It also dynamically creates the subsystem loggers as needed, so it
-// can be used to initialize the logging system.
-func setLogLevels(logLevel string) {
-	// Configure all sub-systems with the new logging level.  Dynamically
-	// create loggers as needed.
-	for subsystemID := range subsystemLoggers {
-		setLogLevel(subsystemID, logLevel)
-	}
-}
-
-// validLogLevel returns whether or not logLevel is a valid debug log level.
-func validLogLevel(logLevel string) bool {
-	_, ok := slog.LevelFromString(logLevel)
-	return ok
-}
-
-// supportedSubsystems returns a sorted slice of the supported subsystems for
-// logging purposes.
-func supportedSubsystems() []string {
-	// Convert the subsystemLoggers map keys to a slice.
-	subsystems := make([]string, 0, len(subsystemLoggers))
-	for subsysID := range subsystemLoggers {
-		subsystems = append(subsystems, subsysID)
-	}
-
-	// Sort the subsytems for stable display.
-	sort.Strings(subsystems)
-	return subsystems
-}
-
-// parseAndSetDebugLevels attempts to parse the specified debug level and set
-// the levels accordingly.  An appropriate error is returned if anything is
-// invalid.
-func parseAndSetDebugLevels(debugLevel string) error {
-	// When the specified string doesn't have any delimters, treat it as
-	// the log level for all subsystems.
-	if !strings.Contains(debugLevel, ",") && !strings.Contains(debugLevel, "=") {
-		// Validate debug log level.
-		if !validLogLevel(debugLevel) {
-			str := "The specified debug level [%v] is invalid"
-			return fmt.Errorf(str, debugLevel)
-		}
-
-		// Change the logging level for all subsystems.
-		setLogLevels(debugLevel)
-
-		return nil
-	}
-
-	// Split the specified string into subsystem/level pairs while detecting
-	// issues and update the log levels accordingly.
-	for _, logLevelPair := range strings.Split(debugLevel, ",") {
-		if !strings.Contains(logLevelPair, "=") {
-			str := "The specified debug level contains an invalid " +
-				"subsystem/level pair [%v]"
-			return fmt.Errorf(str, logLevelPair)
-		}
-
-		// Extract the specified subsystem and log level.
-		fields := strings.Split(logLevelPair, "=")
-		subsysID, logLevel := fields[0], fields[1]
-
-		// Validate subsystem.
-		if _, exists := subsystemLoggers[subsysID]; !exists {
-			str := "The specified subsystem [%v] is invalid -- " +
-				"supported subsytems %v"
-			return fmt.Errorf(str, subsysID, supportedSubsystems())
-		}
-
-		// Validate log level.
-		if !validLogLevel(logLevel) {
-			str := "The specified debug level [%v] is invalid"
-			return fmt.Errorf(str, logLevel)
-		}
-
-		setLogLevel(subsysID, logLevel)
-	}
-
-	return nil
-}
-
 // fatalf logs a message, flushes the logger, and finally exit the process with
 // a non-zero return code.
 func fatalf(format string, args ...interface{}) {
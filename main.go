@@ -14,6 +14,7 @@ import (
 	"github.com/raedahgroup/godcr/app"
 	"github.com/raedahgroup/godcr/app/config"
 	"github.com/raedahgroup/godcr/app/help"
+	"github.com/raedahgroup/godcr/app/logging"
 	"github.com/raedahgroup/godcr/app/walletmediums/dcrlibwallet"
 	"github.com/raedahgroup/godcr/app/walletmediums/dcrwalletrpc"
 	"github.com/raedahgroup/godcr/cli"
@@ -50,15 +51,16 @@ func main() {
 			logRotator.Close()
 		}
 	}()
+	initLoggers(appConfig.LogFormat)
 
 	// Special show command to list supported subsystems and exit.
 	if appConfig.DebugLevel == "show" {
-		fmt.Println("Supported subsystems", supportedSubsystems())
+		fmt.Println("Supported subsystems", logging.Subsystems())
 		os.Exit(0)
 	}
 
 	// Parse, validate, and set debug log level(s).
-	if err := parseAndSetDebugLevels(appConfig.DebugLevel); err != nil {
+	if err := logging.ParseAndSetDebugLevels(appConfig.DebugLevel); err != nil {
 		err := fmt.Errorf("loadConfig: %s", err.Error())
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -197,7 +199,7 @@ func connectViaDcrlibwallet(ctx context.Context, cfg *config.Config) (*dcrlibwal
 	// attempt to load default wallet if set and wallet db can be found
 	if cfg.DefaultWalletDir != "" {
 		netType := filepath.Base(cfg.DefaultWalletDir)
-		walletMiddleware, err := dcrlibwallet.Connect(ctx, cfg.DefaultWalletDir, netType)
+		walletMiddleware, err := dcrlibwallet.Connect(ctx, cfg.DefaultWalletDir, netType, cfg.SyncOptions())
 		if err != nil {
 			return nil, err
 		}
@@ -245,7 +247,7 @@ func enterCliMode(ctx context.Context, walletMiddleware app.WalletMiddleware, ap
 }
 
 func enterHttpMode(ctx context.Context, walletMiddleware app.WalletMiddleware, appConfig *config.Config) {
-	opError = web.StartServer(ctx, walletMiddleware, appConfig.HTTPHost, appConfig.HTTPPort, &appConfig.Settings)
+	opError = web.StartServer(ctx, walletMiddleware, appConfig)
 	// only trigger shutdown if some error occurred, ctx.Err cases would already have triggered shutdown, so ignore
 	if opError != nil && ctx.Err() == nil {
 		beginShutdown <- true
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"image"
+	"sync"
 
 	"github.com/aarzilli/nucular"
 	"github.com/aarzilli/nucular/rect"
 	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/nuklear/nuklog"
 	"github.com/raedahgroup/godcr/nuklear/styles"
 	"github.com/raedahgroup/godcr/nuklear/widgets"
@@ -27,6 +29,9 @@ type Desktop struct {
 	nextPage         string
 	pageChanged      bool
 	syncer           *Syncer
+
+	chainReorgMu      sync.Mutex
+	chainReorgMessage string
 }
 
 func LaunchApp(ctx context.Context, walletMiddleware app.WalletMiddleware) error {
@@ -55,8 +60,16 @@ func LaunchApp(ctx context.Context, walletMiddleware app.WalletMiddleware) error
 		desktop.navPages[page.name] = page.handler
 	}
 
+	// show chain reorganisations in the nav window, the confirmations of transactions displayed before it may have changed
+	walletMiddleware.SetChainReorgListener(func(reorg *walletcore.ChainReorg) {
+		desktop.chainReorgMu.Lock()
+		desktop.chainReorgMessage = walletcore.ChainReorgMessage(reorg)
+		desktop.chainReorgMu.Unlock()
+		masterWindow.Changed()
+	})
+
 	// start syncing in background
-	go desktop.syncer.startSyncing(walletMiddleware, masterWindow)
+	go desktop.syncer.startSyncing(ctx, walletMiddleware, masterWindow)
 
 	// draw master window
 	masterWindow.Main()
@@ -103,6 +116,14 @@ func (desktop *Desktop) renderNavWindow(window *nucular.Window, maxHeight int) {
 			styles.DecredLightBlueColor, widgets.CenterAlign)
 		navGroupWindow.AddHorizontalSpace(10)
 
+		desktop.chainReorgMu.Lock()
+		chainReorgMessage := desktop.chainReorgMessage
+		desktop.chainReorgMu.Unlock()
+		if chainReorgMessage != "" {
+			navGroupWindow.AddWrappedLabelWithColor(chainReorgMessage, widgets.CenterAlign, styles.DecredOrangeColor)
+			navGroupWindow.AddHorizontalSpace(10)
+		}
+
 		for _, page := range getNavPages() {
 			if desktop.currentPage == page.name {
 				navGroupWindow.AddCurrentNavButton(page.label, func() {
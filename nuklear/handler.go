@@ -68,7 +68,7 @@ func getNavPages() []navPage {
 		{
 			name:    "settings",
 			label:   "Settings",
-			handler: &notImplementedNavPageHandler{"Settings"},
+			handler: &pagehandlers.SettingsHandler{},
 		},
 	}
 }
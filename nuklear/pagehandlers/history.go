@@ -2,6 +2,7 @@ package pagehandlers
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/aarzilli/nucular"
 	"github.com/decred/dcrd/dcrutil"
@@ -22,10 +23,13 @@ type HistoryHandler struct {
 	fetchHistoryError      error
 	isFetchingTransactions bool
 
-	selectedTxHash      string
-	selectedTxDetails   *walletcore.Transaction
-	isFetchingTxDetails bool
-	fetchTxDetailsError error
+	// txDetailsMu guards the selected tx fields, which are also updated by the goroutine refreshing the displayed tx
+	txDetailsMu          sync.Mutex
+	selectedTxHash       string
+	selectedTxDetails    *walletcore.Transaction
+	isFetchingTxDetails  bool
+	fetchTxDetailsError  error
+	stopTxDetailsUpdates chan struct{}
 }
 
 func (handler *HistoryHandler) BeforeRender(wallet walletcore.Wallet, refreshWindowDisplay func()) bool {
@@ -67,7 +71,11 @@ func (handler *HistoryHandler) fetchTransactions() {
 }
 
 func (handler *HistoryHandler) Render(window *nucular.Window) {
-	if handler.selectedTxHash == "" {
+	handler.txDetailsMu.Lock()
+	selectedTxHash := handler.selectedTxHash
+	handler.txDetailsMu.Unlock()
+
+	if selectedTxHash == "" {
 		handler.renderHistoryPage(window)
 		return
 	}
@@ -0,0 +1,176 @@
+package pagehandlers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aarzilli/nucular"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/logging"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/nuklear/styles"
+	"github.com/raedahgroup/godcr/nuklear/widgets"
+)
+
+const (
+	spvConnectInputWidth   = 400
+	logSubsystemLabelWidth = 60
+	logLevelComboWidth     = 120
+)
+
+// connectionInfoProvider is implemented by the wallet middleware that is passed to nav page handlers
+type connectionInfoProvider interface {
+	WalletConnectionInfo() (walletcore.ConnectionInfo, error)
+}
+
+type SettingsHandler struct {
+	wallet               walletcore.Wallet
+	spvConnectInput      *nucular.TextEditor
+	spvConnectErr        error
+	spvConnectSaved      bool
+	connectionInfo       walletcore.ConnectionInfo
+	connectionInfoErr    error
+	logLevelIndexes      map[string]int
+	logLevelErr          error
+	refreshWindowDisplay func()
+}
+
+func (handler *SettingsHandler) BeforeRender(wallet walletcore.Wallet, refreshWindowDisplay func()) bool {
+	handler.wallet = wallet
+	handler.refreshWindowDisplay = refreshWindowDisplay
+	handler.spvConnectErr = nil
+	handler.spvConnectSaved = false
+
+	handler.spvConnectInput = &nucular.TextEditor{}
+	handler.spvConnectInput.Flags = nucular.EditClipboard | nucular.EditSimple
+
+	// spv peers are read from the config file so that changes saved previously on this page are shown
+	cfg, err := config.ReadConfigFile()
+	if err != nil {
+		handler.spvConnectErr = fmt.Errorf("error reading config file: %s", err.Error())
+	} else {
+		handler.spvConnectInput.Buffer = []rune(strings.Join(cfg.SPVConnect, ", "))
+	}
+
+	handler.loadConnectionInfo()
+	handler.loadLogLevels()
+	return true
+}
+
+func (handler *SettingsHandler) loadLogLevels() {
+	handler.logLevelErr = nil
+	handler.logLevelIndexes = make(map[string]int)
+	for subsystemID, currentLevel := range logging.SubsystemLevels() {
+		for index, level := range logging.Levels {
+			if level == currentLevel {
+				handler.logLevelIndexes[subsystemID] = index
+			}
+		}
+	}
+}
+
+func (handler *SettingsHandler) loadConnectionInfo() {
+	if provider, ok := handler.wallet.(connectionInfoProvider); ok {
+		handler.connectionInfo, handler.connectionInfoErr = provider.WalletConnectionInfo()
+	} else {
+		handler.connectionInfoErr = errors.New("connection info is not available for this wallet")
+	}
+}
+
+func (handler *SettingsHandler) Render(window *nucular.Window) {
+	widgets.PageContentWindowDefaultPadding("Settings", window, func(contentWindow *widgets.Window) {
+		contentWindow.AddLabelWithFont("SPV Peers", widgets.LeftCenterAlign, styles.BoldPageContentFont)
+		contentWindow.AddWrappedLabelWithColor("Only connect to these peers when syncing (comma separated). "+
+			"Leave empty to discover peers automatically. Changes take effect after godcr is restarted.", widgets.LeftCenterAlign, styles.GrayColor)
+
+		contentWindow.Row(widgets.EditorHeight).Static(spvConnectInputWidth)
+		contentWindow.AddEditorToCurrentRow(handler.spvConnectInput)
+		contentWindow.AddButton("Save", handler.saveSPVConnect)
+
+		if handler.spvConnectErr != nil {
+			contentWindow.DisplayErrorMessage("Error saving spv peers", handler.spvConnectErr)
+		} else if handler.spvConnectSaved {
+			contentWindow.DisplayMessage("SPV peers saved. Restart godcr to sync with the new peers", styles.DecredGreenColor)
+		}
+
+		contentWindow.AddHorizontalSpace(20)
+		contentWindow.AddLabelWithFont("Connected Peers", widgets.LeftCenterAlign, styles.BoldPageContentFont)
+		contentWindow.AddButton("Refresh", func() {
+			handler.loadConnectionInfo()
+			handler.refreshWindowDisplay()
+		})
+
+		switch {
+		case handler.connectionInfoErr != nil:
+			contentWindow.DisplayErrorMessage("Error fetching connected peers", handler.connectionInfoErr)
+		case len(handler.connectionInfo.PeerAddresses) > 0:
+			for _, address := range handler.connectionInfo.PeerAddresses {
+				contentWindow.AddLabel(address, widgets.LeftCenterAlign)
+			}
+		default:
+			contentWindow.AddLabel(fmt.Sprintf("%d connected, addresses not reported by the wallet",
+				handler.connectionInfo.PeersConnected), widgets.LeftCenterAlign)
+			if len(handler.connectionInfo.ConfiguredPeers) > 0 {
+				contentWindow.AddLabel("Configured peers (spvconnect), not necessarily connected:", widgets.LeftCenterAlign)
+				for _, address := range handler.connectionInfo.ConfiguredPeers {
+					contentWindow.AddLabel(address, widgets.LeftCenterAlign)
+				}
+			}
+		}
+
+		if handler.connectionInfo.WalletRPCState != "" {
+			contentWindow.AddLabel(fmt.Sprintf("dcrwallet connection: %s", handler.connectionInfo.WalletRPCState),
+				widgets.LeftCenterAlign)
+		}
+
+		contentWindow.AddHorizontalSpace(20)
+		contentWindow.AddLabelWithFont("Log Levels", widgets.LeftCenterAlign, styles.BoldPageContentFont)
+		contentWindow.AddWrappedLabelWithColor("Changes apply immediately and are saved as debuglevel in the config file.",
+			widgets.LeftCenterAlign, styles.GrayColor)
+
+		for _, subsystemID := range logging.Subsystems() {
+			contentWindow.Row(widgets.EditorHeight).Static(logSubsystemLabelWidth, logLevelComboWidth)
+			contentWindow.Label(subsystemID, widgets.LeftCenterAlign)
+
+			currentLevelIndex := handler.logLevelIndexes[subsystemID]
+			selectedLevelIndex := contentWindow.ComboSimple(logging.Levels, currentLevelIndex, widgets.EditorHeight)
+			if selectedLevelIndex != currentLevelIndex {
+				handler.setLogLevel(subsystemID, selectedLevelIndex)
+			}
+		}
+
+		if handler.logLevelErr != nil {
+			contentWindow.DisplayErrorMessage("Error setting log level", handler.logLevelErr)
+		}
+	})
+}
+
+// setLogLevel changes the log level of the subsystem immediately and saves the levels of all subsystems as debuglevel in the config file
+func (handler *SettingsHandler) setLogLevel(subsystemID string, levelIndex int) {
+	handler.logLevelErr = logging.SetLevel(subsystemID, logging.Levels[levelIndex])
+	if handler.logLevelErr != nil {
+		return
+	}
+	handler.logLevelIndexes[subsystemID] = levelIndex
+
+	handler.logLevelErr = config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+		cnfg.DebugLevel = logging.DebugLevel()
+	})
+}
+
+func (handler *SettingsHandler) saveSPVConnect() {
+	handler.spvConnectSaved = false
+	defer handler.refreshWindowDisplay()
+
+	peers, err := config.ParsePeerAddresses(string(handler.spvConnectInput.Buffer))
+	if err != nil {
+		handler.spvConnectErr = err
+		return
+	}
+
+	handler.spvConnectErr = config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+		cnfg.SPVConnect = peers
+	})
+	handler.spvConnectSaved = handler.spvConnectErr == nil
+}
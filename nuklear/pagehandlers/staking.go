@@ -8,7 +8,9 @@ import (
 
 	"github.com/aarzilli/nucular"
 	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app/vsp"
 	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/nuklear/nuklog"
 	"github.com/raedahgroup/godcr/nuklear/styles"
 	"github.com/raedahgroup/godcr/nuklear/widgets"
 )
@@ -26,6 +28,9 @@ type StakingHandler struct {
 	numTicketsInput       *nucular.TextEditor
 	numTicketsInputErrStr string
 
+	vspConfig *vsp.Config
+	useVSP    bool
+
 	isPurchasingTickets    bool
 	purchasedTicketsHashes []string
 	purchaseTicketsError   error
@@ -48,6 +53,14 @@ func (handler *StakingHandler) BeforeRender(wallet walletcore.Wallet, refreshWin
 	handler.numTicketsInput = &nucular.TextEditor{}
 	handler.numTicketsInput.Flags = nucular.EditClipboard | nucular.EditSimple
 
+	// purchase through the configured vsp by default, if any
+	vspConfig, err := vsp.ReadConfig()
+	if err != nil {
+		nuklog.LogError(err)
+	}
+	handler.vspConfig = vspConfig
+	handler.useVSP = vspConfig != nil
+
 	handler.isPurchasingTickets = false
 	handler.purchasedTicketsHashes = nil
 	handler.purchaseTicketsError = nil
@@ -122,6 +135,11 @@ func (handler *StakingHandler) displayPurchaseTicketForm(contentWindow *widgets.
 		contentWindow.Master().Changed()
 	})
 
+	if handler.vspConfig != nil {
+		vspCheckboxText := fmt.Sprintf("Purchase through %s (%.2f%% pool fees)", handler.vspConfig.Host(), handler.vspConfig.PoolFees)
+		contentWindow.AddCheckbox(vspCheckboxText, &handler.useVSP, nil)
+	}
+
 	contentWindow.AddHorizontalSpace(10)
 	contentWindow.Row(widgets.EditorHeight).Static(contentWindow.LabelWidth("Number of Tickets"), numTicketsInputWidth)
 	contentWindow.AddLabelsToCurrentRow(widgets.NewLabelTableCell("Number of Tickets", widgets.LeftCenterAlign))
@@ -206,6 +224,10 @@ func (handler *StakingHandler) submit(passphrase string, window *nucular.Window)
 		Account:               uint32(sourceAccount),
 	}
 
+	if handler.useVSP && handler.vspConfig != nil {
+		handler.vspConfig.ApplyToPurchaseRequest(&request)
+	}
+
 	ticketHashes, sendErr := handler.wallet.PurchaseTicket(context.Background(), request)
 	if sendErr != nil {
 		handler.purchaseTicketsError = sendErr
@@ -4,107 +4,172 @@ import (
 	"fmt"
 	"image/color"
 	"strconv"
+	"time"
 
 	"github.com/aarzilli/nucular"
 	"github.com/decred/dcrd/dcrutil"
+	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/nuklear/styles"
 	"github.com/raedahgroup/godcr/nuklear/widgets"
 )
 
 const (
 	dividerHeight = 10
+
+	// txDetailsRefreshInterval is how often the displayed transaction is re-fetched to update its confirmations and status
+	txDetailsRefreshInterval = 10 * time.Second
 )
 
 func (handler *HistoryHandler) clearTxDetails() {
+	handler.txDetailsMu.Lock()
+	defer handler.txDetailsMu.Unlock()
+
+	handler.stopTxDetailsRefresh()
 	handler.selectedTxHash = ""
 	handler.selectedTxDetails = nil
 	handler.isFetchingTxDetails = false
 	handler.fetchTxDetailsError = nil
 }
 
+// stopTxDetailsRefresh stops the goroutine refreshing the previously selected transaction, if any.
+// handler.txDetailsMu must be held when calling this.
+func (handler *HistoryHandler) stopTxDetailsRefresh() {
+	if handler.stopTxDetailsUpdates != nil {
+		close(handler.stopTxDetailsUpdates)
+		handler.stopTxDetailsUpdates = nil
+	}
+}
+
 func (handler *HistoryHandler) gotoTransactionDetails(txHash string, window *widgets.Window) {
+	handler.txDetailsMu.Lock()
+	handler.stopTxDetailsRefresh()
 	handler.selectedTxHash = txHash
+	handler.selectedTxDetails = nil
+	handler.fetchTxDetailsError = nil
+	handler.isFetchingTxDetails = true
+	stop := make(chan struct{})
+	handler.stopTxDetailsUpdates = stop
+	handler.txDetailsMu.Unlock()
+
+	go func() {
+		handler.fetchTxDetails(txHash)
+		handler.refreshTxDetails(txHash, stop)
+	}()
+
 	window.Master().Changed()
 }
 
-func (handler *HistoryHandler) renderTransactionDetailsPage(window *nucular.Window) {
-	if handler.selectedTxDetails == nil {
-		handler.isFetchingTxDetails = true
-		go func() {
-			handler.selectedTxDetails, handler.fetchTxDetailsError = handler.wallet.GetTransaction(handler.selectedTxHash)
-			handler.isFetchingTxDetails = false
-			window.Master().Changed()
-		}()
+// fetchTxDetails fetches the transaction with `txHash` and refreshes the display,
+// unless the transaction details page was closed or a different transaction was selected while fetching.
+func (handler *HistoryHandler) fetchTxDetails(txHash string) {
+	txDetails, err := handler.wallet.GetTransaction(txHash)
+
+	handler.txDetailsMu.Lock()
+	if handler.selectedTxHash != txHash {
+		handler.txDetailsMu.Unlock()
+		return
+	}
+	if err == nil || handler.selectedTxDetails == nil {
+		// only display errors if no details have been fetched yet, otherwise keep showing the last fetched details
+		handler.selectedTxDetails, handler.fetchTxDetailsError = txDetails, err
+	}
+	handler.isFetchingTxDetails = false
+	handler.txDetailsMu.Unlock()
+
+	handler.refreshWindowDisplay()
+}
+
+// refreshTxDetails periodically re-fetches the selected transaction so that its confirmations and status
+// are updated while it is displayed. Returns when `stop` is closed, i.e. when the transaction details page is closed
+// or another transaction is selected.
+func (handler *HistoryHandler) refreshTxDetails(txHash string, stop chan struct{}) {
+	ticker := time.NewTicker(txDetailsRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			handler.fetchTxDetails(txHash)
+		}
 	}
+}
+
+func (handler *HistoryHandler) renderTransactionDetailsPage(window *nucular.Window) {
+	handler.txDetailsMu.Lock()
+	txDetails, fetchTxDetailsError, isFetchingTxDetails := handler.selectedTxDetails, handler.fetchTxDetailsError, handler.isFetchingTxDetails
+	handler.txDetailsMu.Unlock()
 
 	widgets.PageContentWindowDefaultPadding("Transaction Details", window, func(contentWindow *widgets.Window) {
-		if handler.fetchTxDetailsError != nil {
-			contentWindow.DisplayErrorMessage("Error fetching transaction details", handler.fetchTxDetailsError)
-		} else if handler.selectedTxDetails != nil {
-			handler.displayTransactionDetails(contentWindow)
-		} else if handler.isFetchingTxDetails {
+		if fetchTxDetailsError != nil {
+			contentWindow.DisplayErrorMessage("Error fetching transaction details", fetchTxDetailsError)
+		} else if txDetails != nil {
+			handler.displayTransactionDetails(contentWindow, txDetails)
+		} else if isFetchingTxDetails {
 			contentWindow.DisplayIsLoadingMessage()
 		}
 	})
 }
 
-func (handler *HistoryHandler) displayTransactionDetails(contentWindow *widgets.Window) {
-	if handler.selectedTxHash == "" {
-		return
-	}
-
+func (handler *HistoryHandler) displayTransactionDetails(contentWindow *widgets.Window, txDetails *walletcore.Transaction) {
 	var statusColor color.RGBA
-	if handler.selectedTxDetails.Status == "Confirmed" {
+	if txDetails.Status == "Confirmed" {
 		statusColor = styles.DecredGreenColor
 	} else {
 		statusColor = styles.DecredOrangeColor
 	}
 
 	// we create our tables here so that we are able to calculate our window height using table data
+	blockHeight := "-"
+	if txDetails.BlockHeight > 0 {
+		blockHeight = strconv.Itoa(int(txDetails.BlockHeight))
+	}
+
 	txDetailsTable := widgets.NewTable()
 	txDetailsTable.AddRow(
 		widgets.NewLabelTableCell("Confirmations", "LC"),
-		widgets.NewLabelTableCell(strconv.Itoa(int(handler.selectedTxDetails.Confirmations)), "LC"),
+		widgets.NewLabelTableCell(strconv.Itoa(int(txDetails.Confirmations)), "LC"),
 	)
 	txDetailsTable.AddRow(
 		widgets.NewLabelTableCell("Hash", "LC"),
-		widgets.NewLabelTableCell(handler.selectedTxDetails.Hash, "LC"),
+		widgets.NewLabelTableCell(txDetails.Hash, "LC"),
 	)
 	txDetailsTable.AddRow(
 		widgets.NewLabelTableCell("Block Height", "LC"),
-		widgets.NewLabelTableCell(strconv.Itoa(int(handler.selectedTxDetails.BlockHeight)), "LC"),
+		widgets.NewLabelTableCell(blockHeight, "LC"),
 	)
 	txDetailsTable.AddRow(
 		widgets.NewLabelTableCell("Direction", "LC"),
-		widgets.NewLabelTableCell(handler.selectedTxDetails.Direction.String(), "LC"),
+		widgets.NewLabelTableCell(txDetails.Direction.String(), "LC"),
 	)
 	txDetailsTable.AddRow(
 		widgets.NewLabelTableCell("Type", "LC"),
-		widgets.NewLabelTableCell(handler.selectedTxDetails.Type, "LC"),
+		widgets.NewLabelTableCell(txDetails.Type, "LC"),
 	)
 	txDetailsTable.AddRow(
 		widgets.NewLabelTableCell("Amount", "LC"),
-		widgets.NewLabelTableCell(dcrutil.Amount(handler.selectedTxDetails.Amount).String(), "LC"),
+		widgets.NewLabelTableCell(dcrutil.Amount(txDetails.Amount).String(), "LC"),
 	)
 	txDetailsTable.AddRow(
 		widgets.NewLabelTableCell("Size", "LC"),
-		widgets.NewLabelTableCell(strconv.Itoa(handler.selectedTxDetails.Size)+" Bytes", "LC"),
+		widgets.NewLabelTableCell(strconv.Itoa(txDetails.Size)+" Bytes", "LC"),
 	)
 	txDetailsTable.AddRow(
 		widgets.NewLabelTableCell("Fee", "LC"),
-		widgets.NewLabelTableCell(dcrutil.Amount(handler.selectedTxDetails.Fee).String(), "LC"),
+		widgets.NewLabelTableCell(dcrutil.Amount(txDetails.Fee).String(), "LC"),
 	)
 	txDetailsTable.AddRow(
 		widgets.NewLabelTableCell("Fee Rate", "LC"),
-		widgets.NewLabelTableCell(dcrutil.Amount(handler.selectedTxDetails.FeeRate).String(), "LC"),
+		widgets.NewLabelTableCell(dcrutil.Amount(txDetails.FeeRate).String()+"/kB", "LC"),
 	)
 	txDetailsTable.AddRow(
 		widgets.NewLabelTableCell("Status", "LC"),
-		widgets.NewColoredLabelTableCell(handler.selectedTxDetails.Status, "LC", statusColor),
+		widgets.NewColoredLabelTableCell(txDetails.Status, "LC", statusColor),
 	)
 	txDetailsTable.AddRow(
 		widgets.NewLabelTableCell("Date", "LC"),
-		widgets.NewLabelTableCell(fmt.Sprintf("%s UTC", handler.selectedTxDetails.LongTime), "LC"),
+		widgets.NewLabelTableCell(fmt.Sprintf("%s UTC", txDetails.LongTime), "LC"),
 	)
 
 	txInputsTable := widgets.NewTable()
@@ -114,10 +179,10 @@ func (handler *HistoryHandler) displayTransactionDetails(contentWindow *widgets.
 		widgets.NewLabelTableCell("Amount", "LC"),
 	)
 
-	for _, input := range handler.selectedTxDetails.Inputs {
+	for _, input := range txDetails.Inputs {
 		txInputsTable.AddRow(
-			widgets.NewLabelTableCell(input.PreviousTransactionHash, "LC"),
-			widgets.NewLabelTableCell(input.AccountName, "LC"),
+			widgets.NewLabelTableCell(input.PreviousOutpoint, "LC"),
+			widgets.NewLabelTableCell(walletcore.TxAccountName(input.AccountNumber, input.AccountName), "LC"),
 			widgets.NewLabelTableCell(dcrutil.Amount(input.Amount).String(), "LC"),
 		)
 	}
@@ -130,10 +195,14 @@ func (handler *HistoryHandler) displayTransactionDetails(contentWindow *widgets.
 		widgets.NewLabelTableCell("Type", "LC"),
 	)
 
-	for _, output := range handler.selectedTxDetails.Outputs {
+	for _, output := range txDetails.Outputs {
+		address := output.Address
+		if address == "" {
+			address = "(no address)"
+		}
 		txOutputsTable.AddRow(
-			widgets.NewLabelTableCell(output.Address, "LC"),
-			widgets.NewLabelTableCell(output.AccountName, "LC"),
+			widgets.NewLabelTableCell(address, "LC"),
+			widgets.NewLabelTableCell(walletcore.TxAccountName(output.AccountNumber, output.AccountName), "LC"),
 			widgets.NewLabelTableCell(dcrutil.Amount(output.Amount).String(), "LC"),
 			widgets.NewLabelTableCell(output.ScriptType, "LC"),
 		)
@@ -1,6 +1,7 @@
 package nuklear
 
 import (
+	"context"
 	"fmt"
 	"image"
 
@@ -18,6 +19,8 @@ type Syncer struct {
 	showDetails        bool
 	status             defaultsynclistener.SyncStatus
 	syncError          error
+	syncCanceled       bool
+	walletMiddleware   app.WalletMiddleware
 }
 
 func NewSyncer() *Syncer {
@@ -33,9 +36,11 @@ func NewSyncer() *Syncer {
 	return handler
 }
 
-func (s *Syncer) startSyncing(walletMiddleware app.WalletMiddleware, masterWindow nucular.MasterWindow) {
+func (s *Syncer) startSyncing(ctx context.Context, walletMiddleware app.WalletMiddleware, masterWindow nucular.MasterWindow) {
+	s.walletMiddleware = walletMiddleware
+
 	// begin block chain sync now so that when `Render` is called shortly after this, there'd be a report to display
-	walletMiddleware.SyncBlockChain(false, func(report *defaultsynclistener.ProgressReport) {
+	walletMiddleware.SyncBlockChain(ctx, false, func(report *defaultsynclistener.ProgressReport) {
 		progressReport := report.Read()
 
 		s.status = progressReport.Status
@@ -90,6 +95,24 @@ func (s *Syncer) startSyncing(walletMiddleware app.WalletMiddleware, masterWindo
 	})
 }
 
+func (s *Syncer) cancelSync() {
+	s.walletMiddleware.CancelSync()
+	s.syncCanceled = true
+}
+
+func (s *Syncer) restartSync() {
+	if err := s.walletMiddleware.RestartSync(); err != nil {
+		s.syncError = err
+		return
+	}
+	s.syncCanceled = false
+	s.syncError = nil
+	s.percentageProgress = 0
+	s.report = []string{
+		"Starting...",
+	}
+}
+
 func (s *Syncer) isDoneSyncing() bool {
 	return s.status == defaultsynclistener.SyncStatusSuccess
 }
@@ -110,14 +133,20 @@ func (s *Syncer) Render(window *nucular.Window) {
 					for _, report := range s.report {
 						contentWindow.AddLabel(report, widgets.CenterAlign)
 					}
-					return
+				} else {
+					contentWindow.AddLabel(s.report[0], widgets.CenterAlign)
+					contentWindow.AddHorizontalSpace(20)
+					contentWindow.UseFontAndResetToPrevious(styles.PageHeaderFont, func() {
+						contentWindow.SelectableLabel("Tap to view information", widgets.CenterAlign, &s.showDetails)
+					})
 				}
 
-				contentWindow.AddLabel(s.report[0], widgets.CenterAlign)
 				contentWindow.AddHorizontalSpace(20)
-				contentWindow.UseFontAndResetToPrevious(styles.PageHeaderFont, func() {
-					contentWindow.SelectableLabel("Tap to view information", widgets.CenterAlign, &s.showDetails)
-				})
+				if s.syncCanceled || s.status == defaultsynclistener.SyncStatusError {
+					contentWindow.AddButton("Restart Sync", s.restartSync)
+				} else {
+					contentWindow.AddButton("Cancel Sync", s.cancelSync)
+				}
 			}
 
 			if s.syncError != nil {
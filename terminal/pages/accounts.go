@@ -40,10 +40,14 @@ func accountsPage(wallet walletcore.Wallet, hintTextView *primitives.TextView, s
 	hideAccount := primitives.NewCheckbox("Hide this account (Account balance will be ignored): ")
 	defaultAccount := primitives.NewCheckbox("Default account (Make this account default for all outgoing and incoming transactions): ")
 
+	renameAccountForm := primitives.NewForm(false)
+	renameAccountForm.SetBorderPadding(0, 0, 0, 0)
+
 	displayAccountsTable := func() {
 		accountPage.RemoveItem(accountPropertiesTable)
 		accountPage.RemoveItem(hideAccount)
 		accountPage.RemoveItem(defaultAccount)
+		accountPage.RemoveItem(renameAccountForm)
 
 		titleTextView.SetText("Accounts")
 		hintTextView.SetText("TIP: Use ARROW UP/DOWN to select an account,\nENTER to view details, ESC to return to navigation menu")
@@ -65,18 +69,19 @@ func accountsPage(wallet walletcore.Wallet, hintTextView *primitives.TextView, s
 
 	// method for getting transaction details when a tx is selected from the history table
 	var selectedAccount *walletcore.Account
+	var selectedRow int
 	hiddenAccounts := settings.HiddenAccounts
 	accountsTable.SetSelectedFunc(func(row, column int) {
 		accountPage.RemoveItem(accountsTable)
-		selectedRow := row - 1		
+		selectedRow = row - 1
 		selectedAccount = accounts[selectedRow]
 
 		titleTextView.SetText("Account Details")
-		hintTextView.SetText("TIP: Use TAB key to switch between checkbox, \nBACKSPACE to retun to accounts page, ESC to return to navigation menu")
+		hintTextView.SetText("TIP: Use TAB key to switch between checkboxes and rename form, \nBACKSPACE to retun to accounts page, ESC to return to navigation menu")
 
-		accountPage.AddItem(accountPropertiesTable, 9, 0, true)
+		accountPage.AddItem(accountPropertiesTable, 10, 0, true)
 		tviewApp.SetFocus(hideAccount)
-		displayAccountsDetails(wallet.NetType(), selectedAccount, accountPropertiesTable, displayMessage)
+		displayAccountsDetails(wallet, selectedAccount, accountPropertiesTable, displayMessage)
 
 		if settings.DefaultAccount != selectedAccount.Number {
 			defaultAccount.SetChecked(false)
@@ -95,6 +100,46 @@ func accountsPage(wallet walletcore.Wallet, hintTextView *primitives.TextView, s
 
 		accountPage.AddItem(hideAccount, 2, 0, false)
 		accountPage.AddItem(defaultAccount, 2, 0, false)
+
+		renameAccountForm.ClearFields()
+		accountPage.AddItem(renameAccountForm, 4, 0, false)
+	})
+
+	var newAccountName string
+	renameAccountForm.AddInputField("New Account Name:", "", 20, nil, func(text string) {
+		newAccountName = text
+	})
+
+	renameAccountForm.AddButton("Rename", func() {
+		if newAccountName == "" {
+			displayMessage("Error: please specify the new account name")
+			return
+		}
+
+		err := wallet.RenameAccount(selectedAccount.Number, newAccountName)
+		if err != nil {
+			displayMessage(err.Error())
+			return
+		}
+
+		displayMessage("")
+		selectedAccount.Name = newAccountName
+		accountPropertiesTable.SetCellSimple(0, 1, newAccountName)
+		accountsTable.GetCell(selectedRow+1, 0).SetText(fmt.Sprintf("%-5s", newAccountName))
+		renameAccountForm.ClearFields()
+		tviewApp.SetFocus(hideAccount)
+	})
+
+	renameAccountForm.SetCancelFunc(displayAccountsTable)
+
+	// handler for moving from the rename form back to the hide account checkbox
+	renameAccountForm.GetButton(0).SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyTab {
+			tviewApp.SetFocus(hideAccount)
+			return nil
+		}
+
+		return event
 	})
 
 	defaultAccount.SetChangedFunc(func(checked bool) {
@@ -192,7 +237,7 @@ func accountsPage(wallet walletcore.Wallet, hintTextView *primitives.TextView, s
 		}
 
 		if event.Key() == tcell.KeyTab {
-			tviewApp.SetFocus(hideAccount)
+			tviewApp.SetFocus(renameAccountForm)
 			return nil
 		}
 
@@ -224,9 +269,9 @@ func displayWalletAcccounts(accounts []*walletcore.Account, accountsTable *tview
 	}
 }
 
-func displayAccountsDetails(netType string, account *walletcore.Account, accountPropertiesTable *tview.Table, displayMessage func(string)) {
+func displayAccountsDetails(wallet walletcore.Wallet, account *walletcore.Account, accountPropertiesTable *tview.Table, displayMessage func(string)) {
 	var networkHDPath string
-	if netType == "testnet3" {
+	if wallet.NetType() == "testnet3" {
 		networkHDPath = walletcore.TestnetHDPath
 	} else {
 		networkHDPath = walletcore.MainnetHDPath
@@ -239,6 +284,7 @@ func displayAccountsDetails(netType string, account *walletcore.Account, account
 	accountPropertiesTable.SetCellSimple(4, 0, "Account Number:")
 	accountPropertiesTable.SetCellSimple(5, 0, "HD Path:")
 	accountPropertiesTable.SetCellSimple(6, 0, "Keys:")
+	accountPropertiesTable.SetCellSimple(7, 0, "Extended Public Key:")
 
 	accountPropertiesTable.SetCellSimple(0, 1, account.Name)
 	accountPropertiesTable.SetCellSimple(1, 1, account.Balance.Total.String())
@@ -248,4 +294,11 @@ func displayAccountsDetails(netType string, account *walletcore.Account, account
 	accountPropertiesTable.SetCellSimple(6, 1, fmt.Sprintf("%d External, %d Internal, %d Imported", account.ExternalKeyCount,
 		account.InternalKeyCount,
 		account.ImportedKeyCount))
+
+	xpub, err := wallet.AccountExtendedPubKey(account.Number)
+	if err != nil {
+		displayMessage(fmt.Sprintf("Error fetching account extended public key: %s", err.Error()))
+		xpub = "-"
+	}
+	accountPropertiesTable.SetCellSimple(7, 1, xpub)
 }
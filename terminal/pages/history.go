@@ -3,6 +3,7 @@ package pages
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/gdamore/tcell"
@@ -18,6 +19,16 @@ var displayedTxHashes []string
 var txPerPage int32 = walletcore.TransactionHistoryCountPerPage
 var totalTxCount int
 
+// txDetailsRefreshInterval is how often the confirmations and status of the displayed transaction are updated
+const txDetailsRefreshInterval = 10 * time.Second
+
+// rows of the transaction details table that change as the transaction gets more confirmations
+const (
+	txDetailsStatusRow = iota + 1
+	txDetailsConfirmationsRow
+	txDetailsBlockHeightRow
+)
+
 func historyPage(wallet walletcore.Wallet, hintTextView *primitives.TextView, tviewApp *tview.Application, clearFocus func()) tview.Primitive {
 	// parent flexbox layout container to hold other primitives
 	body := tview.NewFlex().SetDirection(tview.FlexRow)
@@ -72,7 +83,14 @@ func historyPage(wallet walletcore.Wallet, hintTextView *primitives.TextView, tv
 
 	transactionDetailsTable := tview.NewTable().SetBorders(false)
 
+	// closed to stop updating the confirmations of the displayed transaction when the details table is closed
+	var stopTxDetailsUpdates chan struct{}
+
 	displayHistoryTable := func() {
+		if stopTxDetailsUpdates != nil {
+			close(stopTxDetailsUpdates)
+			stopTxDetailsUpdates = nil
+		}
 		body.RemoveItem(transactionDetailsTable)
 
 		titleTextView.SetText(historyPageTitle)
@@ -115,7 +133,10 @@ func historyPage(wallet walletcore.Wallet, hintTextView *primitives.TextView, tv
 
 		tviewApp.SetFocus(transactionDetailsTable)
 
-		displayTxDetails(txHash, wallet, displayMessage, transactionDetailsTable)
+		if displayTxDetails(txHash, wallet, displayMessage, transactionDetailsTable) {
+			stopTxDetailsUpdates = make(chan struct{})
+			go updateTxDetailsConfirmations(txHash, wallet, transactionDetailsTable, tviewApp, stopTxDetailsUpdates)
+		}
 	})
 
 	// handler for returning back to history table
@@ -205,31 +226,36 @@ func fetchAndDisplayTransactions(txOffset int, wallet walletcore.Wallet, history
 	return
 }
 
-func displayTxDetails(txHash string, wallet walletcore.Wallet, displayError func(string, bool), transactionDetailsTable *tview.Table) {
+// displayTxDetails fetches the transaction with `txHash` and displays its details on `transactionDetailsTable`.
+// Returns false if the transaction could not be fetched.
+func displayTxDetails(txHash string, wallet walletcore.Wallet, displayError func(string, bool), transactionDetailsTable *tview.Table) bool {
 	tx, err := wallet.GetTransaction(txHash)
 	if err != nil {
 		displayError(err.Error(), true)
+		return false
 	}
 
 	transactionDetailsTable.SetCellSimple(0, 0, "Hash")
-	transactionDetailsTable.SetCellSimple(1, 0, "Confirmations")
-	transactionDetailsTable.SetCellSimple(2, 0, "Included in block")
-	transactionDetailsTable.SetCellSimple(3, 0, "Type")
-	transactionDetailsTable.SetCellSimple(4, 0, "Amount")
-	transactionDetailsTable.SetCellSimple(5, 0, "Date")
-	transactionDetailsTable.SetCellSimple(6, 0, "Direction")
-	transactionDetailsTable.SetCellSimple(7, 0, "Fee")
-	transactionDetailsTable.SetCellSimple(8, 0, "Fee Rate")
+	transactionDetailsTable.SetCellSimple(txDetailsStatusRow, 0, "Status")
+	transactionDetailsTable.SetCellSimple(txDetailsConfirmationsRow, 0, "Confirmations")
+	transactionDetailsTable.SetCellSimple(txDetailsBlockHeightRow, 0, "Included in block")
+	transactionDetailsTable.SetCellSimple(4, 0, "Type")
+	transactionDetailsTable.SetCellSimple(5, 0, "Amount")
+	transactionDetailsTable.SetCellSimple(6, 0, "Date")
+	transactionDetailsTable.SetCellSimple(7, 0, "Direction")
+	transactionDetailsTable.SetCellSimple(8, 0, "Size")
+	transactionDetailsTable.SetCellSimple(9, 0, "Fee")
+	transactionDetailsTable.SetCellSimple(10, 0, "Fee Rate")
 
 	transactionDetailsTable.SetCellSimple(0, 1, tx.Hash)
-	transactionDetailsTable.SetCellSimple(1, 1, strconv.Itoa(int(tx.Confirmations)))
-	transactionDetailsTable.SetCellSimple(2, 1, strconv.Itoa(int(tx.BlockHeight)))
-	transactionDetailsTable.SetCellSimple(3, 1, tx.Type)
-	transactionDetailsTable.SetCellSimple(4, 1, dcrutil.Amount(tx.Amount).String())
-	transactionDetailsTable.SetCellSimple(5, 1, fmt.Sprintf("%s UTC", tx.LongTime))
-	transactionDetailsTable.SetCellSimple(6, 1, tx.Direction.String())
-	transactionDetailsTable.SetCellSimple(7, 1, dcrutil.Amount(tx.Fee).String())
-	transactionDetailsTable.SetCellSimple(8, 1, fmt.Sprintf("%s/kB", dcrutil.Amount(tx.FeeRate)))
+	setTxDetailsConfirmations(tx, transactionDetailsTable)
+	transactionDetailsTable.SetCellSimple(4, 1, tx.Type)
+	transactionDetailsTable.SetCellSimple(5, 1, dcrutil.Amount(tx.Amount).String())
+	transactionDetailsTable.SetCellSimple(6, 1, fmt.Sprintf("%s UTC", tx.LongTime))
+	transactionDetailsTable.SetCellSimple(7, 1, tx.Direction.String())
+	transactionDetailsTable.SetCellSimple(8, 1, fmt.Sprintf("%.1f kB", float64(tx.Size)/1000))
+	transactionDetailsTable.SetCellSimple(9, 1, dcrutil.Amount(tx.Fee).String())
+	transactionDetailsTable.SetCellSimple(10, 1, fmt.Sprintf("%s/kB", dcrutil.Amount(tx.FeeRate)))
 
 	// calculate max number of digits after decimal point for inputs and outputs
 	inputsAndOutputsAmount := make([]int64, 0, len(tx.Inputs)+len(tx.Outputs))
@@ -246,11 +272,11 @@ func displayTxDetails(txHash string, wallet walletcore.Wallet, displayError func
 		return godcrUtils.FormatAmountDisplay(amount, maxDecimalPlacesForInputsAndOutputsAmounts)
 	}
 
-	transactionDetailsTable.SetCellSimple(9, 0, "-Inputs-")
+	transactionDetailsTable.SetCellSimple(transactionDetailsTable.GetRowCount(), 0, "-Inputs-")
 	for _, txIn := range tx.Inputs {
 		row := transactionDetailsTable.GetRowCount()
 		transactionDetailsTable.SetCell(row, 0, tview.NewTableCell(formatAmount(txIn.Amount)).SetAlign(tview.AlignRight))
-		transactionDetailsTable.SetCellSimple(row, 1, txIn.PreviousOutpoint)
+		transactionDetailsTable.SetCellSimple(row, 1, fmt.Sprintf("%s (%s)", txIn.PreviousOutpoint, walletcore.TxAccountName(txIn.AccountNumber, txIn.AccountName)))
 	}
 
 	row := transactionDetailsTable.GetRowCount()
@@ -261,10 +287,62 @@ func displayTxDetails(txHash string, wallet walletcore.Wallet, displayError func
 
 		if txOut.Address == "" {
 			transactionDetailsTable.SetCellSimple(row, 0, fmt.Sprintf("  %s (no address)", outputAmount))
+			transactionDetailsTable.SetCellSimple(row, 1, txOut.ScriptType)
 			continue
 		}
 
 		transactionDetailsTable.SetCell(row, 0, tview.NewTableCell(outputAmount).SetAlign(tview.AlignRight))
-		transactionDetailsTable.SetCellSimple(row, 1, fmt.Sprintf("%s (%s)", txOut.Address, txOut.AccountName))
+		transactionDetailsTable.SetCellSimple(row, 1, fmt.Sprintf("%s (%s) %s", txOut.Address,
+			walletcore.TxAccountName(txOut.AccountNumber, txOut.AccountName), txOut.ScriptType))
+	}
+
+	return true
+}
+
+// setTxDetailsConfirmations sets the details of `tx` that change as the transaction gets more confirmations
+func setTxDetailsConfirmations(tx *walletcore.Transaction, transactionDetailsTable *tview.Table) {
+	statusColor := helpers.DecredOrangeColor
+	if tx.Status == "Confirmed" {
+		statusColor = helpers.DecredGreenColor
+	}
+	transactionDetailsTable.SetCell(txDetailsStatusRow, 1, tview.NewTableCell(tx.Status).SetTextColor(statusColor))
+	transactionDetailsTable.SetCellSimple(txDetailsConfirmationsRow, 1, strconv.Itoa(int(tx.Confirmations)))
+
+	if tx.BlockHeight > 0 {
+		transactionDetailsTable.SetCellSimple(txDetailsBlockHeightRow, 1, strconv.Itoa(int(tx.BlockHeight)))
+	} else {
+		transactionDetailsTable.SetCellSimple(txDetailsBlockHeightRow, 1, "-")
+	}
+}
+
+// updateTxDetailsConfirmations periodically re-fetches the displayed transaction to keep its status and confirmations current.
+// Stops when `stop` is closed.
+func updateTxDetailsConfirmations(txHash string, wallet walletcore.Wallet, transactionDetailsTable *tview.Table,
+	tviewApp *tview.Application, stop chan struct{}) {
+
+	ticker := time.NewTicker(txDetailsRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		tx, err := wallet.GetTransaction(txHash)
+		if err != nil {
+			// keep displaying the last fetched details, the next attempt may succeed
+			continue
+		}
+
+		tviewApp.QueueUpdateDraw(func() {
+			select {
+			case <-stop:
+				// details table closed while fetching the tx
+			default:
+				setTxDetailsConfirmations(tx, transactionDetailsTable)
+			}
+		})
 	}
 }
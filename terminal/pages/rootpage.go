@@ -1,17 +1,19 @@
 package pages
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gdamore/tcell"
 	"github.com/raedahgroup/godcr/app"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/terminal/helpers"
 	"github.com/raedahgroup/godcr/terminal/primitives"
 	"github.com/rivo/tview"
 )
 
-func RootPage(tviewApp *tview.Application, walletMiddleware app.WalletMiddleware, settings config.Settings) tview.Primitive {
+func RootPage(ctx context.Context, tviewApp *tview.Application, walletMiddleware app.WalletMiddleware, settings config.Settings) tview.Primitive {
 	gridLayout := tview.NewGrid().
 		SetRows(3, 1, 0, 1, 2).
 		SetColumns(20, 2, 0, 2)
@@ -77,11 +79,11 @@ func RootPage(tviewApp *tview.Application, walletMiddleware app.WalletMiddleware
 	})
 
 	menuColumn.AddItem("Security", "", 'u', func() {
-		displayPage(securityPage(tviewApp.SetFocus, clearFocus))
+		displayPage(securityPage(walletMiddleware, hintTextView, tviewApp.SetFocus, clearFocus))
 	})
 
 	menuColumn.AddItem("Settings", "", 't', func() {
-		displayPage(settingsPage(tviewApp.SetFocus, clearFocus))
+		displayPage(settingsPage(walletMiddleware, hintTextView, tviewApp.SetFocus, clearFocus))
 	})
 
 	menuColumn.AddItem("Exit", "", 'e', func() {
@@ -93,6 +95,13 @@ func RootPage(tviewApp *tview.Application, walletMiddleware app.WalletMiddleware
 	header.SetBackgroundColor(helpers.DecredBlueColor)
 	gridLayout.AddItem(header, 0, 0, 1, 4, 0, 0, false)
 
+	// show chain reorganisations in the header, the confirmations of transactions displayed before it may have changed
+	walletMiddleware.SetChainReorgListener(func(reorg *walletcore.ChainReorg) {
+		tviewApp.QueueUpdateDraw(func() {
+			header.SetText(fmt.Sprintf("\n %s %s\n %s", app.DisplayName, netType, walletcore.ChainReorgMessage(reorg)))
+		})
+	})
+
 	menuColumn.SetShortcutColor(helpers.DecredLightBlueColor)
 	gridLayout.AddItem(menuColumn, 1, 0, 4, 1, 0, 0, true)
 
@@ -105,7 +114,7 @@ func RootPage(tviewApp *tview.Application, walletMiddleware app.WalletMiddleware
 	})
 
 	tviewApp.QueueUpdateDraw(func() {
-		displayPage(LaunchSyncPage(tviewApp, walletMiddleware, displayPage, hintTextView, tviewApp.SetFocus, clearFocus))
+		displayPage(LaunchSyncPage(ctx, tviewApp, walletMiddleware, displayPage, hintTextView, tviewApp.SetFocus, clearFocus))
 	})
 
 	return gridLayout
@@ -1,28 +1,125 @@
 package pages
 
 import (
-	"github.com/gdamore/tcell"
+	"context"
+	"strconv"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/terminal/helpers"
 	"github.com/raedahgroup/godcr/terminal/primitives"
 	"github.com/rivo/tview"
 )
 
-func securityPage(setFocus func(p tview.Primitive) *tview.Application, clearFocus func()) tview.Primitive {
+func securityPage(wallet walletcore.Wallet, hintTextView *primitives.TextView, setFocus func(p tview.Primitive) *tview.Application, clearFocus func()) tview.Primitive {
 	body := tview.NewFlex().SetDirection(tview.FlexRow)
 
 	body.AddItem(primitives.NewLeftAlignedTextView("Security"), 2, 1, false)
 
-	body.AddItem(primitives.NewLeftAlignedTextView("Security page coming soon").SetTextColor(helpers.DecredGreenColor), 0, 1, false)
+	messageTextView := primitives.WordWrappedTextView("")
+
+	clearMessage := func() {
+		body.RemoveItem(messageTextView)
+	}
 
-	body.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Key() == tcell.KeyEscape {
-			clearFocus()
-			return nil
+	displayMessage := func(message string, error bool) {
+		clearMessage()
+		messageTextView.SetText(message)
+		if error {
+			messageTextView.SetTextColor(helpers.DecredOrangeColor)
+		} else {
+			messageTextView.SetTextColor(helpers.DecredGreenColor)
 		}
+		body.AddItem(messageTextView, 2, 0, false)
+	}
 
-		return event
-	})
+	body.AddItem(tview.NewTextView().SetText("-Import Private Key or Script-").SetTextColor(helpers.DecredLightBlueColor), 2, 0, false)
+	body.AddItem(importForm(wallet, displayMessage, clearMessage, setFocus, clearFocus), 0, 1, true)
 
 	setFocus(body)
+
+	hintTextView.SetText("TIP: Move around with TAB and SHIFT+TAB. ESC to return to navigation menu")
+
 	return body
 }
+
+func importForm(wallet walletcore.Wallet, displayMessage func(message string, error bool), clearMessage func(),
+	setFocus func(p tview.Primitive) *tview.Application, clearFocus func()) *tview.Pages {
+
+	pages := tview.NewPages()
+
+	form := primitives.NewForm(true)
+	form.SetBorderPadding(0, 0, 0, 0)
+	pages.AddPage("form", form, true, true)
+
+	importTypes := []string{"Private Key (WIF)", "Redeem Script (hex)"}
+	var importTypeIndex int
+	form.AddDropDown("Import:", importTypes, 0, func(_ string, optionIndex int) {
+		importTypeIndex = optionIndex
+	})
+
+	var importValue string
+	form.AddPasswordField("Private Key / Script:", "", 40, '*', func(text string) {
+		importValue = text
+	})
+
+	rescan := true
+	form.AddCheckbox("Rescan Blockchain:", true, func(checked bool) {
+		rescan = checked
+	})
+
+	var rescanFromHeight string
+	form.AddInputField("Rescan From Height:", "0", 10, tview.InputFieldInteger, func(text string) {
+		rescanFromHeight = text
+	})
+
+	form.AddButton("Import", func() {
+		if importValue == "" {
+			displayMessage("Error: please specify the private key or script to import", true)
+			return
+		}
+
+		var height int32
+		if rescan && rescanFromHeight != "" {
+			parsedHeight, err := strconv.ParseInt(rescanFromHeight, 10, 32)
+			if err != nil || parsedHeight < 0 {
+				displayMessage("Error: invalid rescan height", true)
+				return
+			}
+			height = int32(parsedHeight)
+		}
+
+		helpers.RequestSpendingPassphrase(pages, func(passphrase string) {
+			setFocus(form)
+
+			var err error
+			if importTypeIndex == 0 {
+				err = wallet.ImportPrivateKey(context.Background(), importValue, passphrase, rescan, height)
+			} else {
+				err = wallet.ImportScript(context.Background(), importValue, passphrase, rescan, height)
+			}
+			if err != nil {
+				displayMessage(err.Error(), true)
+				return
+			}
+
+			displayMessage("Import successful", false)
+
+			// reset form, ClearFields unchecks the rescan checkbox without triggering its changed func
+			form.ClearFields()
+			rescan = false
+			setFocus(form.GetFormItem(0))
+		}, func() {
+			setFocus(form)
+		})
+	})
+
+	form.AddButton("Clear", func() {
+		form.ClearFields()
+		rescan = false
+		clearMessage()
+	})
+
+	form.SetCancelFunc(clearFocus)
+
+	return pages
+}
@@ -1,18 +1,147 @@
 package pages
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/gdamore/tcell"
+	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/logging"
 	"github.com/raedahgroup/godcr/terminal/helpers"
 	"github.com/raedahgroup/godcr/terminal/primitives"
 	"github.com/rivo/tview"
 )
 
-func settingsPage(setFocus func(p tview.Primitive) *tview.Application, clearFocus func()) tview.Primitive {
+func settingsPage(walletMiddleware app.WalletMiddleware, hintTextView *primitives.TextView, setFocus func(p tview.Primitive) *tview.Application, clearFocus func()) tview.Primitive {
 	body := tview.NewFlex().SetDirection(tview.FlexRow)
 
 	body.AddItem(primitives.NewLeftAlignedTextView("Settings"), 2, 1, false)
 
-	body.AddItem(primitives.NewLeftAlignedTextView("Settings page coming soon").SetTextColor(helpers.DecredGreenColor), 0, 1, false)
+	messageTextView := primitives.WordWrappedTextView("")
+	displayMessage := func(message string, color tcell.Color) {
+		body.RemoveItem(messageTextView)
+		if message != "" {
+			messageTextView.SetText(message).SetTextColor(color)
+			body.AddItem(messageTextView, 2, 0, false)
+		}
+	}
+
+	// spv peers are read from the config file so that changes saved previously on this page are shown
+	var spvConnect string
+	if cfg, err := config.ReadConfigFile(); err != nil {
+		displayMessage(fmt.Sprintf("Error reading config file: %s", err.Error()), helpers.DecredOrangeColor)
+	} else {
+		spvConnect = strings.Join(cfg.SPVConnect, ", ")
+	}
+
+	body.AddItem(primitives.NewLeftAlignedTextView("SPV Peers (comma separated, leave empty to discover peers automatically)"), 1, 0, false)
+
+	spvConnectForm := primitives.NewForm(false)
+	spvConnectForm.SetBorderPadding(0, 0, 0, 0)
+	spvConnectForm.AddInputField("Only connect to:", spvConnect, 50, nil, func(text string) {
+		spvConnect = text
+	})
+	spvConnectForm.AddButton("Save", func() {
+		peers, err := config.ParsePeerAddresses(spvConnect)
+		if err != nil {
+			displayMessage(err.Error(), helpers.DecredOrangeColor)
+			return
+		}
+
+		err = config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+			cnfg.SPVConnect = peers
+		})
+		if err != nil {
+			displayMessage(err.Error(), helpers.DecredOrangeColor)
+			return
+		}
+		displayMessage("SPV peers saved. Restart godcr to sync with the new peers", helpers.DecredGreenColor)
+	})
+	spvConnectForm.SetCancelFunc(clearFocus)
+	body.AddItem(spvConnectForm, 4, 0, true)
+
+	// log levels are changed immediately and saved as debuglevel in the config file
+	body.AddItem(primitives.NewLeftAlignedTextView("Log Levels (saved as debuglevel in the config file)"), 1, 0, false)
+
+	logLevelsForm := primitives.NewForm(false)
+	logLevelsForm.SetBorderPadding(0, 0, 0, 0)
+	logLevelsForm.SetItemPadding(0)
+	subsystems := logging.Subsystems()
+	subsystemLevels := logging.SubsystemLevels()
+	for _, subsystemID := range subsystems {
+		subsystemID := subsystemID
+		currentLevelIndex := 0
+		for index, level := range logging.Levels {
+			if level == subsystemLevels[subsystemID] {
+				currentLevelIndex = index
+			}
+		}
+
+		logLevelsForm.AddDropDown(fmt.Sprintf("%-5s", subsystemID), logging.Levels, currentLevelIndex, func(level string, _ int) {
+			if level == subsystemLevels[subsystemID] {
+				return
+			}
+			if err := logging.SetLevel(subsystemID, level); err != nil {
+				displayMessage(err.Error(), helpers.DecredOrangeColor)
+				return
+			}
+			subsystemLevels[subsystemID] = level
+
+			err := config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+				cnfg.DebugLevel = logging.DebugLevel()
+			})
+			if err != nil {
+				displayMessage(fmt.Sprintf("%s log level set to %s but could not be saved: %s", subsystemID, level, err.Error()),
+					helpers.DecredOrangeColor)
+				return
+			}
+			displayMessage(fmt.Sprintf("%s log level set to %s", subsystemID, level), helpers.DecredGreenColor)
+		})
+	}
+	logLevelsForm.SetCancelFunc(clearFocus)
+	body.AddItem(logLevelsForm, len(subsystems)+1, 0, false)
+
+	spvConnectForm.GetButton(0).SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyTab && len(subsystems) > 0 {
+			setFocus(logLevelsForm)
+			return nil
+		}
+
+		return event
+	})
+
+	if len(subsystems) > 0 {
+		logLevelsForm.GetFormItemBox(len(subsystems) - 1).SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == tcell.KeyTab {
+				setFocus(spvConnectForm)
+				return nil
+			}
+
+			return event
+		})
+	}
+
+	body.AddItem(primitives.NewLeftAlignedTextView("Connected Peers"), 1, 0, false)
+	connectedPeersTextView := primitives.WordWrappedTextView("")
+	connectionInfo, err := walletMiddleware.WalletConnectionInfo()
+	switch {
+	case err != nil:
+		connectedPeersTextView.SetText(fmt.Sprintf("Error fetching connected peers: %s", err.Error())).SetTextColor(helpers.DecredOrangeColor)
+	case len(connectionInfo.PeerAddresses) > 0:
+		connectedPeersTextView.SetText(strings.Join(connectionInfo.PeerAddresses, "\n"))
+	case len(connectionInfo.ConfiguredPeers) > 0:
+		connectedPeersTextView.SetText(fmt.Sprintf("%d connected, addresses not reported by the wallet\n\n"+
+			"Configured peers (spvconnect), not necessarily connected:\n%s",
+			connectionInfo.PeersConnected, strings.Join(connectionInfo.ConfiguredPeers, "\n")))
+	default:
+		connectedPeersTextView.SetText(fmt.Sprintf("%d connected, addresses not reported by the wallet", connectionInfo.PeersConnected))
+	}
+	if connectionInfo.WalletRPCState != "" {
+		connectedPeersTextView.SetText(fmt.Sprintf("%s\n\ndcrwallet connection: %s", connectedPeersTextView.GetText(),
+			connectionInfo.WalletRPCState))
+	}
+	body.AddItem(connectedPeersTextView, 0, 1, false)
 
 	body.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		if event.Key() == tcell.KeyEscape {
@@ -23,6 +152,8 @@ func settingsPage(setFocus func(p tview.Primitive) *tview.Application, clearFocu
 		return event
 	})
 
-	setFocus(body)
+	hintTextView.SetText("TIP: Use TAB to move between the peers field, save button and log levels, ESC to return to navigation menu")
+
+	setFocus(spvConnectForm)
 	return body
 }
@@ -7,7 +7,9 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/gdamore/tcell"
 	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app/vsp"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/terminal/helpers"
 	"github.com/raedahgroup/godcr/terminal/primitives"
@@ -45,6 +47,17 @@ func stakingPage(wallet walletcore.Wallet, hintTextView *primitives.TextView, se
 		body.AddItem(stakeInfo, 3, 0, false)
 	}
 
+	body.AddItem(tview.NewTextView().SetText("-Vote Choices-").SetTextColor(helpers.DecredLightBlueColor), 2, 0, false)
+	voteChoices, agendasCount, err := voteChoicesForm(wallet, displayMessage, clearFocus)
+	if err != nil {
+		errorText := fmt.Sprintf("Error fetching vote choices: %s", err.Error())
+		displayMessage(errorText, true)
+	} else if agendasCount == 0 {
+		body.AddItem(primitives.NewLeftAlignedTextView("There are no agendas to vote on"), 2, 0, false)
+	} else {
+		body.AddItem(voteChoices, agendasCount*2, 0, true)
+	}
+
 	body.AddItem(tview.NewTextView().SetText("-Purchase Ticket-").SetTextColor(helpers.DecredLightBlueColor), 2, 0, false)
 	purchaseTicket, err := purchaseTicketForm(wallet, displayMessage, clearMessage, setFocus, clearFocus)
 	if err != nil {
@@ -52,6 +65,17 @@ func stakingPage(wallet walletcore.Wallet, hintTextView *primitives.TextView, se
 		displayMessage(errorText, true)
 	} else {
 		body.AddItem(purchaseTicket, 0, 1, true)
+
+		// move from the last vote choice dropdown to the purchase ticket form with TAB
+		if voteChoices != nil && agendasCount > 0 {
+			voteChoices.GetFormItemBox(agendasCount - 1).SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+				if event.Key() == tcell.KeyTab {
+					setFocus(purchaseTicket)
+					return nil
+				}
+				return event
+			})
+		}
 	}
 
 	setFocus(body)
@@ -73,6 +97,50 @@ func stakeInfoFlex(wallet walletcore.Wallet) (*primitives.TextView, error) {
 	return primitives.NewLeftAlignedTextView(stakingReport), nil
 }
 
+// voteChoicesForm displays a dropdown of vote choices for each agenda, updating the wallet's vote choice when an option is selected
+func voteChoicesForm(wallet walletcore.Wallet, displayMessage func(message string, error bool), clearFocus func()) (*primitives.Form, int, error) {
+	agendas, err := wallet.VoteChoices(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	form := primitives.NewForm(true)
+	form.SetBorderPadding(0, 0, 0, 0)
+
+	for _, agenda := range agendas {
+		agendaID := agenda.ID
+		currentChoice := agenda.VoteChoice
+
+		choiceIDs := make([]string, len(agenda.Choices))
+		selectedChoiceIndex := -1
+		for i, choice := range agenda.Choices {
+			choiceIDs[i] = choice.ID
+			if choice.ID == currentChoice {
+				selectedChoiceIndex = i
+			}
+		}
+
+		form.AddDropDown(agendaID+":", choiceIDs, selectedChoiceIndex, func(choiceID string, _ int) {
+			if choiceID == currentChoice {
+				return
+			}
+
+			err := wallet.SetVoteChoice(context.Background(), agendaID, choiceID)
+			if err != nil {
+				displayMessage(err.Error(), true)
+				return
+			}
+
+			currentChoice = choiceID
+			displayMessage(fmt.Sprintf("Vote choice for %s set to %s", agendaID, choiceID), false)
+		})
+	}
+
+	form.SetCancelFunc(clearFocus)
+
+	return form, len(agendas), nil
+}
+
 func purchaseTicketForm(wallet walletcore.Wallet, displayMessage func(message string, error bool), clearMessage func(),
 	setFocus func(p tview.Primitive) *tview.Application, clearFocus func()) (*tview.Pages, error) {
 
@@ -103,6 +171,19 @@ func purchaseTicketForm(wallet walletcore.Wallet, displayMessage func(message st
 		spendUnconfirmed = checked
 	})
 
+	// purchase through the configured vsp by default, if any
+	vspConfig, err := vsp.ReadConfig()
+	if err != nil {
+		return nil, err
+	}
+	useVSP := vspConfig != nil
+	if useVSP {
+		vspCheckboxLabel := fmt.Sprintf("Purchase through %s (%.2f%% fees):", vspConfig.Host(), vspConfig.PoolFees)
+		form.AddCheckbox(vspCheckboxLabel, true, func(checked bool) {
+			useVSP = checked
+		})
+	}
+
 	form.AddButton("Purchase", func() {
 		if len(numTickets) == 0 {
 			displayMessage("Error: please specify the number of tickets to purchase", true)
@@ -113,7 +194,11 @@ func purchaseTicketForm(wallet walletcore.Wallet, displayMessage func(message st
 			setFocus(form)
 
 			accountNumber := accountSelectionWidgetData.SelectedAccountNumber
-			ticketHashes, err := purchaseTickets(passphrase, numTickets, accountNumber, spendUnconfirmed, wallet)
+			var purchaseVSPConfig *vsp.Config
+			if useVSP {
+				purchaseVSPConfig = vspConfig
+			}
+			ticketHashes, err := purchaseTickets(passphrase, numTickets, accountNumber, spendUnconfirmed, purchaseVSPConfig, wallet)
 			if err != nil {
 				displayMessage(err.Error(), true)
 				return
@@ -140,7 +225,7 @@ func purchaseTicketForm(wallet walletcore.Wallet, displayMessage func(message st
 	return pages, nil
 }
 
-func purchaseTickets(passphrase, numTickets string, accountNum uint32, spendUnconfirmed bool, wallet walletcore.Wallet) ([]string, error) {
+func purchaseTickets(passphrase, numTickets string, accountNum uint32, spendUnconfirmed bool, vspConfig *vsp.Config, wallet walletcore.Wallet) ([]string, error) {
 	nTickets, err := strconv.ParseUint(string(numTickets), 10, 32)
 	if err != nil {
 		return nil, err
@@ -158,6 +243,10 @@ func purchaseTickets(passphrase, numTickets string, accountNum uint32, spendUnco
 		Account:               accountNum,
 	}
 
+	if vspConfig != nil {
+		vspConfig.ApplyToPurchaseRequest(&request)
+	}
+
 	ticketHashes, err := wallet.PurchaseTicket(context.Background(), request)
 	if err != nil {
 		return nil, err
@@ -1,6 +1,7 @@
 package pages
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -12,7 +13,7 @@ import (
 	"github.com/rivo/tview"
 )
 
-func LaunchSyncPage(tviewApp *tview.Application, walletMiddleware app.WalletMiddleware, displayPage func(tview.Primitive), hintTextView *primitives.TextView, setFocus func(p tview.Primitive) *tview.Application, clearFocus func()) tview.Primitive {
+func LaunchSyncPage(ctx context.Context, tviewApp *tview.Application, walletMiddleware app.WalletMiddleware, displayPage func(tview.Primitive), hintTextView *primitives.TextView, setFocus func(p tview.Primitive) *tview.Application, clearFocus func()) tview.Primitive {
 	syncPage := tview.NewFlex().SetDirection(tview.FlexRow)
 
 	// page title
@@ -61,36 +62,59 @@ func LaunchSyncPage(tviewApp *tview.Application, walletMiddleware app.WalletMidd
 		})
 	}
 
-	startSync(walletMiddleware, updateStatus, handleError, afterSyncing)
+	startSync(ctx, walletMiddleware, updateStatus, handleError, afterSyncing)
 
-	var cancelTriggered bool
+	const syncingHint = "Press ESC twice to cancel synchronization process"
+	const canceledHint = "Press R to restart synchronization or ESC to go to overview"
+
+	var cancelTriggered, syncCanceled bool
 	syncPage.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Key() == tcell.KeyEsc {
-			if cancelTriggered {
-				clearFocus()
-				displayPage(overviewPage(walletMiddleware, hintTextView, tviewApp, clearFocus))
-			} else {
-				cancelTriggered = true
-				// remove cancel trigger after 1 second if user does not press escape again within that time
-				go func() {
-					<-time.After(1 * time.Second)
-					cancelTriggered = false
-				}()
+		switch {
+		case event.Key() == tcell.KeyEsc && syncCanceled:
+			clearFocus()
+			displayPage(overviewPage(walletMiddleware, hintTextView, tviewApp, clearFocus))
+
+		case event.Key() == tcell.KeyEsc && cancelTriggered:
+			walletMiddleware.CancelSync()
+			syncCanceled = true
+			hintTextView.SetText(canceledHint)
+
+		case event.Key() == tcell.KeyEsc:
+			cancelTriggered = true
+			// remove cancel trigger after 1 second if user does not press escape again within that time
+			go func() {
+				<-time.After(1 * time.Second)
+				cancelTriggered = false
+			}()
+
+		case event.Rune() == 'r' || event.Rune() == 'R':
+			if !syncCanceled {
+				return event
+			}
+			if err := walletMiddleware.RestartSync(); err != nil {
+				handleError("Error restarting sync: " + err.Error())
+				return nil
 			}
-			return nil
+			syncCanceled, cancelTriggered = false, false
+			errorTextView.SetText("")
+			syncPage.RemoveItem(errorTextView)
+			hintTextView.SetText(syncingHint)
+
+		default:
+			return event
 		}
-		return event
+		return nil
 	})
 
-	hintTextView.SetText("Press ESC twice to cancel synchronization process").SetTextColor(helpers.HintTextColor)
+	hintTextView.SetText(syncingHint).SetTextColor(helpers.HintTextColor)
 
 	setFocus(syncPage)
 	return syncPage
 }
 
-func startSync(walletMiddleware app.WalletMiddleware, updateStatus func([]string), handleError func(string), afterSyncing func()) {
+func startSync(ctx context.Context, walletMiddleware app.WalletMiddleware, updateStatus func([]string), handleError func(string), afterSyncing func()) {
 	var isFirstSyncCompleted = true
-	walletMiddleware.SyncBlockChain(false, func(report *defaultsynclistener.ProgressReport) {
+	walletMiddleware.SyncBlockChain(ctx, false, func(report *defaultsynclistener.ProgressReport) {
 		progressReport := report.Read()
 
 		if progressReport.Status == defaultsynclistener.SyncStatusSuccess {
@@ -9,9 +9,8 @@ import (
 	"github.com/rivo/tview"
 )
 
-// todo the ctx variable should be stored somewhere for as long as this terminal app is open
-// it will be necessary for use in some wallet operations
-func StartTerminalApp(_ context.Context, walletMiddleware app.WalletMiddleware, settings config.Settings) error {
+// StartTerminalApp launches the terminal interface, blockchain sync is stopped when ctx is canceled
+func StartTerminalApp(ctx context.Context, walletMiddleware app.WalletMiddleware, settings config.Settings) error {
 	tviewApp := tview.NewApplication()
 
 	// todo: main.go now requires that the user select a wallet or create one before launching interfaces, so need for this check
@@ -25,7 +24,7 @@ func StartTerminalApp(_ context.Context, walletMiddleware app.WalletMiddleware,
 	//	tviewApp.SetRoot(pages.CreateWalletPage(tviewApp, walletMiddleware), true)
 	//}
 
-	tviewApp.SetRoot(pages.RootPage(tviewApp, walletMiddleware, settings), true)
+	tviewApp.SetRoot(pages.RootPage(ctx, tviewApp, walletMiddleware, settings), true)
 
 	// `Run` blocks until app.Stop() is called before returning
 	return tviewApp.Run()
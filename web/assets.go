@@ -0,0 +1,34 @@
+package web
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// embeddedAssets holds the page templates and built static files, so that the http mode works from any working directory.
+// Static files must be built with `go generate ./web` before building godcr for the frontend bundle to be included.
+//
+//go:generate sh -c "cd static/app && yarn install && yarn build"
+//go:embed views static/dist
+var embeddedAssets embed.FS
+
+// loadAssets returns the file system to load page templates (views/) and static files (static/dist/) from.
+// If `assetsDir` is set, files are read from that directory instead of the copies built into the binary,
+// which allows changes to templates and static files to be tested without rebuilding godcr.
+func loadAssets(assetsDir string) (fs.FS, error) {
+	if assetsDir == "" {
+		return embeddedAssets, nil
+	}
+
+	for _, requiredDir := range []string{"views", filepath.Join("static", "dist")} {
+		if info, err := os.Stat(filepath.Join(assetsDir, requiredDir)); err != nil || !info.IsDir() {
+			return nil, fmt.Errorf("web assets directory %s does not contain %s", assetsDir, requiredDir)
+		}
+	}
+
+	fmt.Println("Loading web assets from", assetsDir)
+	return os.DirFS(assetsDir), nil
+}
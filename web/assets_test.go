@@ -0,0 +1,23 @@
+package web
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestStaticFilesBuilt checks that the frontend bundle embedded into the binary has been built.
+// The bundle is not committed, so the test is skipped on checkouts where `go generate ./web` has not been run.
+func TestStaticFilesBuilt(t *testing.T) {
+	bundlePath := filepath.Join("static", "dist", "js", "app.bundle.js")
+	bundleInfo, err := os.Stat(bundlePath)
+	if os.IsNotExist(err) {
+		t.Skipf("frontend bundle %s has not been built, run `go generate ./web` before building godcr", bundlePath)
+	}
+	if err != nil {
+		t.Fatalf("error reading frontend bundle %s: %v", bundlePath, err)
+	}
+	if bundleInfo.Size() == 0 {
+		t.Errorf("frontend bundle %s is empty, rebuild it with `go generate ./web`", bundlePath)
+	}
+}
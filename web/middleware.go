@@ -0,0 +1,68 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/raedahgroup/godcr/app/logging"
+	"github.com/raedahgroup/godcr/web/weblog"
+)
+
+// maxRequestBodySize is the largest request body accepted by the server.
+// Wallet requests are small form or json submissions, so anything larger is rejected.
+const maxRequestBodySize = 1 << 20 // 1MB
+
+// securityHeaders sets response headers that instruct browsers to block content sniffing, framing of pages by other sites
+// and loading of scripts or other resources from sources other than this server.
+func securityHeaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		headers := res.Header()
+		headers.Set("X-Content-Type-Options", "nosniff")
+		headers.Set("X-Frame-Options", "DENY")
+		headers.Set("Referrer-Policy", "same-origin")
+		headers.Set("Content-Security-Policy", fmt.Sprintf("default-src 'self'; "+
+			"script-src 'self' 'unsafe-inline'; style-src 'self' 'unsafe-inline'; img-src 'self' data:; "+
+			"connect-src 'self' ws://%s wss://%s; frame-ancestors 'none'; form-action 'self'; base-uri 'self'",
+			req.Host, req.Host))
+		if req.TLS != nil {
+			headers.Set("Strict-Transport-Security", "max-age=31536000")
+		}
+
+		next.ServeHTTP(res, req)
+	})
+}
+
+// limitRequestBody prevents clients from sending request bodies larger than `maxBytes`.
+// Reading beyond the limit returns an error, which causes form and json parsing in handlers to fail.
+func limitRequestBody(maxBytes int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			if req.ContentLength > maxBytes {
+				http.Error(res, "Request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			req.Body = http.MaxBytesReader(res, req.Body, maxBytes)
+			next.ServeHTTP(res, req)
+		})
+	}
+}
+
+// logRequests logs the method, path, response status and duration of every request at the debug level
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		start := time.Now()
+		wrappedRes := middleware.NewWrapResponseWriter(res, req.ProtoMajor)
+		next.ServeHTTP(wrappedRes, req)
+
+		weblog.Log.Debug("Web request", logging.Fields{
+			"method":      req.Method,
+			"path":        req.URL.Path,
+			"status":      wrappedRes.Status(),
+			"duration_ms": time.Since(start).Milliseconds(),
+			"remote_addr": req.RemoteAddr,
+		})
+	})
+}
@@ -0,0 +1,563 @@
+package routes
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/go-chi/chi"
+	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/dcrlibwallet/txindex"
+	"github.com/raedahgroup/godcr/app/vsp"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+const apiBasePath = "/api/v1"
+
+// apiError is returned by api handlers when a request cannot be completed.
+// It is written to the response as {"error": {"code": ..., "message": ...}} with `status` as the http status code.
+type apiError struct {
+	status  int
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func newAPIError(status int, code, message string, args ...interface{}) *apiError {
+	return &apiError{status: status, Code: code, Message: fmt.Sprintf(message, args...)}
+}
+
+func badRequestError(message string, args ...interface{}) *apiError {
+	return newAPIError(http.StatusBadRequest, "bad_request", message, args...)
+}
+
+func walletError(err error) *apiError {
+	return newAPIError(http.StatusInternalServerError, "wallet_error", err.Error())
+}
+
+// apiHandler processes an api request and returns the data to send in the response, or an error
+type apiHandler func(req *http.Request) (interface{}, *apiError)
+
+// apiParam describes a path or query parameter accepted by an api endpoint
+type apiParam struct {
+	name        string
+	in          string // "path" or "query"
+	paramType   string // openapi primitive type e.g. "integer", "string", "boolean"
+	description string
+}
+
+// apiEndpoint describes an api endpoint. The list of endpoints in `apiEndpoints`
+// is used both to register the api routes and to generate the api's OpenAPI description.
+type apiEndpoint struct {
+	method      string
+	path        string
+	summary     string
+	params      []apiParam
+	requestBody interface{} // zero value of the type expected in the json request body, if any
+	response    interface{} // zero value of the type returned as `data` in the response
+	// requiresSync is true for endpoints that can only return accurate data after the blockchain is synced
+	requiresSync bool
+	handler      apiHandler
+}
+
+type apiAddress struct {
+	Address string `json:"address"`
+}
+
+type apiTransactionHistory struct {
+	Transactions []*walletcore.Transaction `json:"transactions"`
+	TotalCount   int                       `json:"total_count"`
+}
+
+type apiSendRequest struct {
+	SourceAccount         uint32                            `json:"source_account"`
+	RequiredConfirmations *int32                            `json:"required_confirmations"`
+	Destinations          []txhelper.TransactionDestination `json:"destinations"`
+	UtxoKeys              []string                          `json:"utxo_keys"`
+	ChangeDestinations    []txhelper.TransactionDestination `json:"change_destinations"`
+	Passphrase            string                            `json:"passphrase"`
+}
+
+type apiSendResponse struct {
+	TransactionHash string `json:"transaction_hash"`
+}
+
+type apiTicketsInfo struct {
+	StakeInfo   *walletcore.StakeInfo `json:"stake_info"`
+	TicketPrice float64               `json:"ticket_price"`
+}
+
+type apiPurchaseTicketsRequest struct {
+	Account               uint32 `json:"account"`
+	NumTickets            uint32 `json:"num_tickets"`
+	RequiredConfirmations *int32 `json:"required_confirmations"`
+	Passphrase            string `json:"passphrase"`
+	UseVSP                bool   `json:"use_vsp"`
+}
+
+type apiPurchaseTicketsResponse struct {
+	TicketHashes []string `json:"ticket_hashes"`
+}
+
+// apiSyncStatusResponse is the blockchain sync progress returned by the sync-status endpoint
+type apiSyncStatusResponse struct {
+	Status             string `json:"status"` // not_started, in_progress, success or error
+	Done               bool   `json:"done"`
+	Error              string `json:"error,omitempty"`
+	CurrentStep        int32  `json:"current_step"`
+	TotalSyncProgress  int32  `json:"total_sync_progress"` // percentage
+	TotalTimeRemaining string `json:"total_time_remaining"`
+	ConnectedPeers     int32  `json:"connected_peers"`
+}
+
+var accountNumberParam = apiParam{name: "accountNumber", in: "path", paramType: "integer", description: "Account number"}
+var minConfParam = apiParam{name: "min-conf", in: "query", paramType: "integer", description: "Minimum number of confirmations, defaults to 2"}
+
+func (routes *Routes) apiEndpoints() []*apiEndpoint {
+	return []*apiEndpoint{
+		{
+			method:   http.MethodGet,
+			path:     "/sync-status",
+			summary:  "Blockchain sync progress",
+			response: apiSyncStatusResponse{},
+			handler:  routes.apiSyncStatus,
+		},
+		{
+			method:       http.MethodGet,
+			path:         "/accounts",
+			summary:      "List wallet accounts with their balances",
+			params:       []apiParam{minConfParam},
+			response:     []*walletcore.Account{},
+			requiresSync: true,
+			handler:      routes.apiAccounts,
+		},
+		{
+			method:       http.MethodGet,
+			path:         "/accounts/{accountNumber}/balance",
+			summary:      "Balance of an account",
+			params:       []apiParam{accountNumberParam, minConfParam},
+			response:     walletcore.Balance{},
+			requiresSync: true,
+			handler:      routes.apiAccountBalance,
+		},
+		{
+			method:  http.MethodGet,
+			path:    "/accounts/{accountNumber}/address",
+			summary: "Receive address for an account",
+			params: []apiParam{accountNumberParam, {name: "new", in: "query", paramType: "boolean",
+				description: "Generate a new address even if the current address has not been used"}},
+			response:     apiAddress{},
+			requiresSync: true,
+			handler:      routes.apiReceiveAddress,
+		},
+		{
+			method:       http.MethodGet,
+			path:         "/accounts/{accountNumber}/utxos",
+			summary:      "Unspent outputs in an account",
+			params:       []apiParam{accountNumberParam, minConfParam},
+			response:     []*walletcore.UnspentOutput{},
+			requiresSync: true,
+			handler:      routes.apiUnspentOutputs,
+		},
+		{
+			method:  http.MethodGet,
+			path:    "/transactions",
+			summary: "Transaction history",
+			params: []apiParam{
+				{name: "offset", in: "query", paramType: "integer", description: "Number of transactions to skip"},
+				{name: "count", in: "query", paramType: "integer", description: "Number of transactions to return, defaults to 25"},
+				{name: "filter", in: "query", paramType: "string", description: "Transaction filter e.g. Sent, Received, Ticket"},
+			},
+			response:     apiTransactionHistory{},
+			requiresSync: true,
+			handler:      routes.apiTransactionHistory,
+		},
+		{
+			method:       http.MethodGet,
+			path:         "/transactions/{hash}",
+			summary:      "Details of a transaction",
+			params:       []apiParam{{name: "hash", in: "path", paramType: "string", description: "Transaction hash"}},
+			response:     walletcore.Transaction{},
+			requiresSync: true,
+			handler:      routes.apiTransactionDetails,
+		},
+		{
+			method:       http.MethodPost,
+			path:         "/transactions",
+			summary:      "Send a transaction, optionally using specific unspent outputs as inputs",
+			requestBody:  apiSendRequest{},
+			response:     apiSendResponse{},
+			requiresSync: true,
+			handler:      routes.apiSendTransaction,
+		},
+		{
+			method:       http.MethodGet,
+			path:         "/tickets",
+			summary:      "Stake info and current ticket price",
+			response:     apiTicketsInfo{},
+			requiresSync: true,
+			handler:      routes.apiTicketsInfo,
+		},
+		{
+			method:       http.MethodPost,
+			path:         "/tickets",
+			summary:      "Purchase tickets",
+			requestBody:  apiPurchaseTicketsRequest{},
+			response:     apiPurchaseTicketsResponse{},
+			requiresSync: true,
+			handler:      routes.apiPurchaseTickets,
+		},
+	}
+}
+
+func (routes *Routes) registerAPIRoutes(router chi.Router) {
+	endpoints := routes.apiEndpoints()
+	for _, endpoint := range endpoints {
+		router.Method(endpoint.method, endpoint.path, routes.serveAPIEndpoint(endpoint))
+	}
+
+	openAPIDescription := openAPIDescription(endpoints)
+	router.Get("/openapi.json", func(res http.ResponseWriter, req *http.Request) {
+		renderJSON(openAPIDescription, res)
+	})
+
+	router.NotFound(func(res http.ResponseWriter, req *http.Request) {
+		renderAPIError(newAPIError(http.StatusNotFound, "not_found", "%s %s is not a valid api endpoint", req.Method, req.URL.Path), res)
+	})
+	router.MethodNotAllowed(func(res http.ResponseWriter, req *http.Request) {
+		renderAPIError(newAPIError(http.StatusMethodNotAllowed, "method_not_allowed", "%s is not allowed for %s", req.Method, req.URL.Path), res)
+	})
+}
+
+// serveAPIEndpoint returns an http handler that checks that the wallet is ready for the endpoint
+// before calling the endpoint's handler and writes the handler's result to the response.
+func (routes *Routes) serveAPIEndpoint(endpoint *apiEndpoint) http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		if err := routes.checkWalletReadyForAPI(endpoint.requiresSync); err != nil {
+			renderAPIError(err, res)
+			return
+		}
+
+		data, err := endpoint.handler(req)
+		if err != nil {
+			renderAPIError(err, res)
+			return
+		}
+
+		renderAPIData(data, res)
+	}
+}
+
+func (routes *Routes) checkWalletReadyForAPI(requiresSync bool) *apiError {
+	if !routes.walletMiddleware.IsWalletOpen() {
+		return newAPIError(http.StatusServiceUnavailable, "wallet_not_open", "Wallet is not open. Restart the server")
+	}
+	if !requiresSync {
+		return nil
+	}
+
+	if routes.blockchainSynced() {
+		return nil
+	}
+
+	syncProgressReport := routes.syncProgress().Read()
+	switch syncProgressReport.Status {
+	case defaultsynclistener.SyncStatusError:
+		return newAPIError(http.StatusServiceUnavailable, "sync_error", "Blockchain sync failed: %s", syncProgressReport.Error)
+	default:
+		return newAPIError(http.StatusServiceUnavailable, "sync_in_progress", "Blockchain sync is in progress")
+	}
+}
+
+func renderAPIData(data interface{}, res http.ResponseWriter) {
+	renderJSON(map[string]interface{}{"data": data}, res)
+}
+
+func renderAPIError(err *apiError, res http.ResponseWriter) {
+	d, _ := json.Marshal(map[string]interface{}{"error": err})
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(err.status)
+	res.Write(d)
+}
+
+func accountNumberFromRequest(req *http.Request) (uint32, *apiError) {
+	accountNumberStr := chi.URLParam(req, "accountNumber")
+	accountNumber, err := strconv.ParseUint(accountNumberStr, 10, 32)
+	if err != nil {
+		return 0, badRequestError("Invalid account number: %s", accountNumberStr)
+	}
+	return uint32(accountNumber), nil
+}
+
+func requiredConfirmationsFromRequest(req *http.Request) (int32, *apiError) {
+	minConfStr := req.URL.Query().Get("min-conf")
+	if minConfStr == "" {
+		return walletcore.DefaultRequiredConfirmations, nil
+	}
+
+	minConf, err := strconv.ParseInt(minConfStr, 10, 32)
+	if err != nil || minConf < 0 {
+		return 0, badRequestError("Invalid min-conf value: %s", minConfStr)
+	}
+	return int32(minConf), nil
+}
+
+// requiredConfirmationsFromBody returns the required_confirmations value sent in a request body or the default if none was sent
+func requiredConfirmationsFromBody(requiredConfirmations *int32) (int32, *apiError) {
+	if requiredConfirmations == nil {
+		return walletcore.DefaultRequiredConfirmations, nil
+	}
+	if *requiredConfirmations < 0 {
+		return 0, badRequestError("Invalid required_confirmations value: %d", *requiredConfirmations)
+	}
+	return *requiredConfirmations, nil
+}
+
+func decodeAPIRequestBody(req *http.Request, body interface{}) *apiError {
+	if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+		return badRequestError("Invalid request body: %s", err.Error())
+	}
+	return nil
+}
+
+func (routes *Routes) apiSyncStatus(req *http.Request) (interface{}, *apiError) {
+	syncInfo := routes.syncProgress().Read()
+
+	var status string
+	switch syncInfo.Status {
+	case defaultsynclistener.SyncStatusInProgress:
+		status = "in_progress"
+	case defaultsynclistener.SyncStatusSuccess:
+		status = "success"
+	case defaultsynclistener.SyncStatusError:
+		status = "error"
+	default:
+		status = "not_started"
+	}
+
+	return apiSyncStatusResponse{
+		Status:             status,
+		Done:               syncInfo.Done,
+		Error:              syncInfo.Error,
+		CurrentStep:        int32(syncInfo.CurrentStep),
+		TotalSyncProgress:  int32(syncInfo.TotalSyncProgress),
+		TotalTimeRemaining: syncInfo.TotalTimeRemaining,
+		ConnectedPeers:     syncInfo.ConnectedPeers,
+	}, nil
+}
+
+func (routes *Routes) apiAccounts(req *http.Request) (interface{}, *apiError) {
+	requiredConfirmations, apiErr := requiredConfirmationsFromRequest(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	accounts, err := routes.walletMiddleware.AccountsOverview(requiredConfirmations)
+	if err != nil {
+		return nil, walletError(err)
+	}
+	return accounts, nil
+}
+
+func (routes *Routes) apiAccountBalance(req *http.Request) (interface{}, *apiError) {
+	accountNumber, apiErr := accountNumberFromRequest(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	requiredConfirmations, apiErr := requiredConfirmationsFromRequest(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	balance, err := routes.walletMiddleware.AccountBalance(accountNumber, requiredConfirmations)
+	if err != nil {
+		return nil, walletError(err)
+	}
+	return balance, nil
+}
+
+func (routes *Routes) apiReceiveAddress(req *http.Request) (interface{}, *apiError) {
+	accountNumber, apiErr := accountNumberFromRequest(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	var address string
+	var err error
+	if req.URL.Query().Get("new") == "true" {
+		address, err = routes.walletMiddleware.GenerateNewAddress(accountNumber)
+	} else {
+		address, err = routes.walletMiddleware.ReceiveAddress(accountNumber)
+	}
+	if err != nil {
+		return nil, walletError(err)
+	}
+	return apiAddress{Address: address}, nil
+}
+
+func (routes *Routes) apiUnspentOutputs(req *http.Request) (interface{}, *apiError) {
+	accountNumber, apiErr := accountNumberFromRequest(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	requiredConfirmations, apiErr := requiredConfirmationsFromRequest(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	utxos, err := routes.walletMiddleware.UnspentOutputs(accountNumber, 0, requiredConfirmations)
+	if err != nil {
+		return nil, walletError(err)
+	}
+	return utxos, nil
+}
+
+func (routes *Routes) apiTransactionHistory(req *http.Request) (interface{}, *apiError) {
+	query := req.URL.Query()
+
+	var offset int64
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		var err error
+		offset, err = strconv.ParseInt(offsetStr, 10, 32)
+		if err != nil || offset < 0 {
+			return nil, badRequestError("Invalid offset: %s", offsetStr)
+		}
+	}
+
+	var count int64 = walletcore.TransactionHistoryCountPerPage
+	if countStr := query.Get("count"); countStr != "" {
+		var err error
+		count, err = strconv.ParseInt(countStr, 10, 32)
+		if err != nil || count <= 0 {
+			return nil, badRequestError("Invalid count: %s", countStr)
+		}
+	}
+
+	filter := txindex.Filter()
+	if selectedFilter := query.Get("filter"); selectedFilter != "" {
+		filter = walletcore.BuildTransactionFilter(selectedFilter)
+	}
+
+	totalCount, err := routes.walletMiddleware.TransactionCount(filter)
+	if err != nil {
+		return nil, walletError(err)
+	}
+
+	txs, err := routes.walletMiddleware.TransactionHistory(int32(offset), int32(count), filter)
+	if err != nil {
+		return nil, walletError(err)
+	}
+
+	return apiTransactionHistory{Transactions: txs, TotalCount: totalCount}, nil
+}
+
+func (routes *Routes) apiTransactionDetails(req *http.Request) (interface{}, *apiError) {
+	txHash := chi.URLParam(req, "hash")
+	if _, err := chainhash.NewHashFromStr(txHash); err != nil {
+		return nil, badRequestError("Invalid transaction hash: %s", txHash)
+	}
+
+	tx, err := routes.walletMiddleware.GetTransaction(txHash)
+	if err == walletcore.ErrTransactionNotFound {
+		return nil, newAPIError(http.StatusNotFound, "not_found", "Transaction %s not found", txHash)
+	}
+	if err != nil {
+		return nil, walletError(err)
+	}
+	return tx, nil
+}
+
+func (routes *Routes) apiSendTransaction(req *http.Request) (interface{}, *apiError) {
+	var sendRequest apiSendRequest
+	if apiErr := decodeAPIRequestBody(req, &sendRequest); apiErr != nil {
+		return nil, apiErr
+	}
+
+	if len(sendRequest.Destinations) == 0 {
+		return nil, badRequestError("At least one destination is required")
+	}
+	if sendRequest.Passphrase == "" {
+		return nil, badRequestError("Passphrase is required")
+	}
+
+	requiredConfirmations, apiErr := requiredConfirmationsFromBody(sendRequest.RequiredConfirmations)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	var txHash string
+	var err error
+	if len(sendRequest.UtxoKeys) > 0 {
+		txHash, err = routes.walletMiddleware.SendFromUTXOs(sendRequest.SourceAccount, requiredConfirmations, sendRequest.UtxoKeys,
+			sendRequest.Destinations, sendRequest.ChangeDestinations, sendRequest.Passphrase)
+	} else {
+		txHash, err = routes.walletMiddleware.SendFromAccount(sendRequest.SourceAccount, requiredConfirmations,
+			sendRequest.Destinations, sendRequest.Passphrase)
+	}
+	if err != nil {
+		return nil, walletError(err)
+	}
+
+	routes.sendWsBalance()
+	return apiSendResponse{TransactionHash: txHash}, nil
+}
+
+func (routes *Routes) apiTicketsInfo(req *http.Request) (interface{}, *apiError) {
+	stakeInfo, err := routes.walletMiddleware.StakeInfo(routes.ctx)
+	if err != nil {
+		return nil, walletError(err)
+	}
+
+	ticketPrice, err := routes.walletMiddleware.TicketPrice(routes.ctx)
+	if err != nil {
+		return nil, walletError(err)
+	}
+
+	return apiTicketsInfo{StakeInfo: stakeInfo, TicketPrice: dcrutil.Amount(ticketPrice).ToCoin()}, nil
+}
+
+func (routes *Routes) apiPurchaseTickets(req *http.Request) (interface{}, *apiError) {
+	var purchaseRequest apiPurchaseTicketsRequest
+	if apiErr := decodeAPIRequestBody(req, &purchaseRequest); apiErr != nil {
+		return nil, apiErr
+	}
+
+	if purchaseRequest.NumTickets == 0 {
+		return nil, badRequestError("num_tickets must be greater than 0")
+	}
+	if purchaseRequest.Passphrase == "" {
+		return nil, badRequestError("Passphrase is required")
+	}
+
+	requiredConfirmations, apiErr := requiredConfirmationsFromBody(purchaseRequest.RequiredConfirmations)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	request := dcrlibwallet.PurchaseTicketsRequest{
+		RequiredConfirmations: uint32(requiredConfirmations),
+		Passphrase:            []byte(purchaseRequest.Passphrase),
+		NumTickets:            purchaseRequest.NumTickets,
+		Account:               purchaseRequest.Account,
+	}
+
+	if purchaseRequest.UseVSP {
+		vspConfig := vsp.ConfigFromSettings(routes.settings)
+		if vspConfig == nil {
+			return nil, badRequestError("No voting service provider has been configured")
+		}
+		vspConfig.ApplyToPurchaseRequest(&request)
+	}
+
+	ticketHashes, err := routes.walletMiddleware.PurchaseTicket(routes.ctx, request)
+	if err != nil {
+		return nil, walletError(err)
+	}
+
+	routes.sendWsBalance()
+	return apiPurchaseTicketsResponse{TicketHashes: ticketHashes}, nil
+}
@@ -0,0 +1,235 @@
+package routes
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/raedahgroup/godcr/app/logging"
+	"github.com/raedahgroup/godcr/web/weblog"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const sessionCookieName = "godcr_session"
+
+// AuthConfig holds the credentials required to access the web interface and api.
+// If neither a username and password nor api tokens are set, authentication is disabled.
+type AuthConfig struct {
+	Username       string
+	PasswordHash   string // bcrypt hash of the password
+	APITokens      []string
+	SessionTimeout time.Duration
+}
+
+// Enabled returns true if credentials have been configured for the web interface or api
+func (authConfig AuthConfig) Enabled() bool {
+	return (authConfig.Username != "" && authConfig.PasswordHash != "") || len(authConfig.APITokens) > 0
+}
+
+// authenticator checks login credentials and api tokens and keeps track of logged in sessions
+type authenticator struct {
+	AuthConfig
+
+	sessionsMu sync.Mutex
+	sessions   map[string]time.Time // session id => expiry time
+}
+
+func newAuthenticator(authConfig AuthConfig) *authenticator {
+	return &authenticator{
+		AuthConfig: authConfig,
+		sessions:   map[string]time.Time{},
+	}
+}
+
+func (auth *authenticator) checkCredentials(username, password string) bool {
+	if auth.Username == "" || auth.PasswordHash == "" {
+		return false
+	}
+
+	usernameMatches := subtle.ConstantTimeCompare([]byte(username), []byte(auth.Username)) == 1
+	// always compare the password, even if the username is wrong, so that response time does not reveal valid usernames
+	passwordMatches := bcrypt.CompareHashAndPassword([]byte(auth.PasswordHash), []byte(password)) == nil
+
+	return usernameMatches && passwordMatches
+}
+
+func (auth *authenticator) checkAPIToken(req *http.Request) bool {
+	authorization := req.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, "Bearer ") {
+		return false
+	}
+	token := []byte(strings.TrimPrefix(authorization, "Bearer "))
+
+	for _, apiToken := range auth.APITokens {
+		if subtle.ConstantTimeCompare(token, []byte(apiToken)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (auth *authenticator) newSession() (sessionID string, expiry time.Time, err error) {
+	randomBytes := make([]byte, 32)
+	if _, err = rand.Read(randomBytes); err != nil {
+		return
+	}
+
+	sessionID = hex.EncodeToString(randomBytes)
+	expiry = time.Now().Add(auth.SessionTimeout)
+
+	auth.sessionsMu.Lock()
+	auth.sessions[sessionID] = expiry
+	auth.sessionsMu.Unlock()
+
+	return
+}
+
+func (auth *authenticator) checkSession(req *http.Request) bool {
+	cookie, err := req.Cookie(sessionCookieName)
+	if err != nil {
+		return false
+	}
+
+	auth.sessionsMu.Lock()
+	defer auth.sessionsMu.Unlock()
+
+	now := time.Now()
+	// remove expired sessions
+	for sessionID, expiry := range auth.sessions {
+		if now.After(expiry) {
+			delete(auth.sessions, sessionID)
+		}
+	}
+
+	_, ok := auth.sessions[cookie.Value]
+	return ok
+}
+
+func (auth *authenticator) endSession(req *http.Request) {
+	cookie, err := req.Cookie(sessionCookieName)
+	if err != nil {
+		return
+	}
+
+	auth.sessionsMu.Lock()
+	delete(auth.sessions, cookie.Value)
+	auth.sessionsMu.Unlock()
+}
+
+// isAuthenticated returns true if authentication is disabled or the request has a valid session cookie or api token
+func (auth *authenticator) isAuthenticated(req *http.Request) bool {
+	return !auth.Enabled() || auth.checkSession(req) || auth.checkAPIToken(req)
+}
+
+// authenticationMiddleware rejects requests that are not authenticated.
+// Api requests get a json error response while other requests are redirected to the login page.
+func (routes *Routes) authenticationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if routes.auth.isAuthenticated(req) {
+			next.ServeHTTP(res, req)
+			return
+		}
+
+		if strings.HasPrefix(req.URL.Path, apiBasePath) || req.URL.Path == "/ws" {
+			renderAPIError(newAPIError(http.StatusUnauthorized, "unauthorized", "Authentication required"), res)
+			return
+		}
+
+		if req.Method != http.MethodGet {
+			http.Error(res, "Authentication required", http.StatusUnauthorized)
+			return
+		}
+
+		loginURL := "/login?redirect=" + url.QueryEscape(req.URL.RequestURI())
+		http.Redirect(res, req, loginURL, http.StatusFound)
+	})
+}
+
+func (routes *Routes) authTemplateFuncMap() template.FuncMap {
+	return template.FuncMap{
+		"authEnabled": routes.auth.Enabled,
+	}
+}
+
+func (routes *Routes) loginPage(res http.ResponseWriter, req *http.Request) {
+	if !routes.auth.Enabled() || routes.auth.checkSession(req) {
+		http.Redirect(res, req, loginRedirectPath(req), http.StatusFound)
+		return
+	}
+
+	data := map[string]interface{}{
+		"redirect":  loginRedirectPath(req),
+		"csrfToken": csrfToken(req),
+	}
+	routes.render("login.html", data, res)
+}
+
+func (routes *Routes) submitLoginForm(res http.ResponseWriter, req *http.Request) {
+	redirectPath := loginRedirectPath(req)
+
+	if !routes.auth.checkCredentials(req.FormValue("username"), req.FormValue("password")) {
+		weblog.Log.Warn("Failed login attempt", logging.Fields{"remote_addr": req.RemoteAddr})
+		data := map[string]interface{}{
+			"redirect":  redirectPath,
+			"username":  req.FormValue("username"),
+			"error":     "Invalid username or password",
+			"csrfToken": csrfToken(req),
+		}
+		res.WriteHeader(http.StatusUnauthorized)
+		routes.render("login.html", data, res)
+		return
+	}
+
+	sessionID, expiry, err := routes.auth.newSession()
+	if err != nil {
+		data := map[string]interface{}{
+			"redirect":  redirectPath,
+			"error":     "Error creating session: " + err.Error(),
+			"csrfToken": csrfToken(req),
+		}
+		routes.render("login.html", data, res)
+		return
+	}
+
+	http.SetCookie(res, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    sessionID,
+		Path:     "/",
+		Expires:  expiry,
+		HttpOnly: true,
+		Secure:   req.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	http.Redirect(res, req, redirectPath, http.StatusFound)
+}
+
+func (routes *Routes) logout(res http.ResponseWriter, req *http.Request) {
+	routes.auth.endSession(req)
+
+	http.SetCookie(res, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+
+	http.Redirect(res, req, "/login", http.StatusFound)
+}
+
+// loginRedirectPath returns the page to open after logging in.
+// Only paths on this server are allowed to prevent redirecting users to other sites.
+func loginRedirectPath(req *http.Request) string {
+	redirectPath := req.FormValue("redirect")
+	if !strings.HasPrefix(redirectPath, "/") || strings.HasPrefix(redirectPath, "//") || strings.HasPrefix(redirectPath, "/\\") {
+		return "/"
+	}
+	return redirectPath
+}
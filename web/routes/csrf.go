@@ -0,0 +1,109 @@
+package routes
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/raedahgroup/godcr/app/logging"
+	"github.com/raedahgroup/godcr/web/weblog"
+)
+
+// csrfCookieName and csrfHeaderName are the names axios uses by default to read the csrf token from a cookie
+// and send it back in a request header, so every ajax request made by the frontend bundle includes the token
+// without any frontend code having to set it.
+const (
+	csrfCookieName = "XSRF-TOKEN"
+	csrfHeaderName = "X-XSRF-TOKEN"
+	csrfFormField  = "csrf_token"
+	csrfTokenSize  = 32
+)
+
+type contextKey string
+
+const csrfTokenContextKey contextKey = "csrfToken"
+
+// csrfMiddleware ensures that every client has a csrf token cookie and rejects state-changing requests
+// that do not include the same token in an X-XSRF-TOKEN header or csrf_token form field.
+// Pages include the token in forms, the cookie is left readable by scripts so that axios can copy it into the header.
+// Other sites cannot read the cookie, so they cannot send a matching header or form field.
+func (routes *Routes) csrfMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		var token string
+		if cookie, err := req.Cookie(csrfCookieName); err == nil && len(cookie.Value) == hex.EncodedLen(csrfTokenSize) {
+			token = cookie.Value
+		} else {
+			tokenBytes := make([]byte, csrfTokenSize)
+			if _, err = rand.Read(tokenBytes); err != nil {
+				http.Error(res, "Error generating csrf token", http.StatusInternalServerError)
+				return
+			}
+			token = hex.EncodeToString(tokenBytes)
+
+			http.SetCookie(res, &http.Cookie{
+				Name:     csrfCookieName,
+				Value:    token,
+				Path:     "/",
+				Secure:   req.TLS != nil,
+				SameSite: http.SameSiteStrictMode,
+			})
+		}
+
+		req = req.WithContext(context.WithValue(req.Context(), csrfTokenContextKey, token))
+
+		if isSafeMethod(req.Method) || routes.isCSRFExempt(req) {
+			next.ServeHTTP(res, req)
+			return
+		}
+
+		requestToken := req.Header.Get(csrfHeaderName)
+		if requestToken == "" {
+			requestToken = req.FormValue(csrfFormField)
+		}
+
+		if subtle.ConstantTimeCompare([]byte(requestToken), []byte(token)) != 1 {
+			weblog.Log.Warn("Rejected request with an invalid csrf token", logging.Fields{
+				"method":      req.Method,
+				"path":        req.URL.Path,
+				"remote_addr": req.RemoteAddr,
+			})
+			if strings.HasPrefix(req.URL.Path, apiBasePath) {
+				renderAPIError(newAPIError(http.StatusForbidden, "invalid_csrf_token", "Missing or invalid csrf token"), res)
+			} else {
+				http.Error(res, "Missing or invalid csrf token. Reload the page and try again", http.StatusForbidden)
+			}
+			return
+		}
+
+		next.ServeHTTP(res, req)
+	})
+}
+
+// isCSRFExempt returns true for requests that a browser cannot be tricked into sending from another site:
+// requests authenticated with an api token (browsers do not attach those automatically)
+// and api requests with a json body (browsers require a cors preflight for those, which this server never approves).
+func (routes *Routes) isCSRFExempt(req *http.Request) bool {
+	if routes.auth.checkAPIToken(req) {
+		return true
+	}
+
+	if !strings.HasPrefix(req.URL.Path, apiBasePath) {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	return err == nil && mediaType == "application/json"
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// csrfToken returns the csrf token set for the request by csrfMiddleware
+func csrfToken(req *http.Request) string {
+	token, _ := req.Context().Value(csrfTokenContextKey).(string)
+	return token
+}
@@ -6,6 +6,7 @@ import (
 	"math"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/go-chi/chi"
@@ -13,6 +14,9 @@ import (
 	"github.com/raedahgroup/dcrlibwallet/txhelper"
 	"github.com/raedahgroup/dcrlibwallet/txindex"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/logging"
+	"github.com/raedahgroup/godcr/app/sweep"
+	"github.com/raedahgroup/godcr/app/vsp"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/web/weblog"
 	"github.com/skip2/go-qrcode"
@@ -51,7 +55,7 @@ import (
 func (routes *Routes) overviewPage(res http.ResponseWriter, req *http.Request) {
 	accounts, err := routes.walletMiddleware.AccountsOverview(walletcore.DefaultRequiredConfirmations)
 	if err != nil {
-		routes.renderError(fmt.Sprintf("Error fetching account balance: %s", err.Error()), res)
+		routes.renderError(fmt.Sprintf("Error fetching account balance: %s", err.Error()), res, req)
 		return
 	}
 
@@ -67,13 +71,30 @@ func (routes *Routes) overviewPage(res http.ResponseWriter, req *http.Request) {
 	}
 	data["transactions"] = txns
 
-	routes.renderPage("overview.html", data, res)
+	unminedTxs, err := routes.walletMiddleware.UnminedTransactions(routes.ctx)
+	if err != nil {
+		data["loadUnminedTransactionsErr"] = fmt.Sprintf("Error fetching unmined transactions: %s", err.Error())
+	}
+	data["unminedTransactions"] = unminedTxs
+
+	routes.renderPage("overview.html", data, res, req)
+}
+
+func (routes *Routes) rebroadcastUnminedTransactions(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	if err := routes.walletMiddleware.RebroadcastUnminedTransactions(routes.ctx); err != nil {
+		data["error"] = err.Error()
+		return
+	}
+	data["success"] = true
 }
 
 func (routes *Routes) sendPage(res http.ResponseWriter, req *http.Request) {
 	accounts, err := routes.walletMiddleware.AccountsOverview(walletcore.DefaultRequiredConfirmations)
 	if err != nil {
-		routes.renderError(fmt.Sprintf("Error fetching accounts: %s", err.Error()), res)
+		routes.renderError(fmt.Sprintf("Error fetching accounts: %s", err.Error()), res, req)
 		return
 	}
 
@@ -81,7 +102,7 @@ func (routes *Routes) sendPage(res http.ResponseWriter, req *http.Request) {
 		"accounts":              accounts,
 		"spendUnconfirmedFunds": routes.settings.SpendUnconfirmed,
 	}
-	routes.renderPage("send.html", data, res)
+	routes.renderPage("send.html", data, res, req)
 }
 
 func (routes *Routes) maxSendAmount(res http.ResponseWriter, req *http.Request) {
@@ -146,7 +167,7 @@ func (routes *Routes) submitSendTxForm(res http.ResponseWriter, req *http.Reques
 func (routes *Routes) receivePage(res http.ResponseWriter, req *http.Request) {
 	accounts, err := routes.walletMiddleware.AccountsOverview(walletcore.DefaultRequiredConfirmations)
 	if err != nil {
-		routes.renderError(fmt.Sprintf("Error fetching accounts: %s", err.Error()), res)
+		routes.renderError(fmt.Sprintf("Error fetching accounts: %s", err.Error()), res, req)
 		return
 	}
 
@@ -156,7 +177,7 @@ func (routes *Routes) receivePage(res http.ResponseWriter, req *http.Request) {
 
 	// don't generate new address by default, return previous unused address if it exists
 	data = routes.generateAddress(data, accounts[0].Number, false)
-	routes.renderPage("receive.html", data, res)
+	routes.renderPage("receive.html", data, res, req)
 }
 
 func (routes *Routes) generateReceiveAddress(res http.ResponseWriter, req *http.Request) {
@@ -273,7 +294,7 @@ func (routes *Routes) historyPage(res http.ResponseWriter, req *http.Request) {
 		txCount, txCountErr := routes.walletMiddleware.TransactionCount(walletcore.BuildTransactionFilter(filter))
 		if txCountErr != nil {
 			routes.renderError(fmt.Sprintf("Cannot load history page. "+
-				"Error getting total transaction count: %s", txCountErr.Error()), res)
+				"Error getting total transaction count: %s", txCountErr.Error()), res, req)
 			return
 		}
 		if txCount == 0 {
@@ -285,7 +306,7 @@ func (routes *Routes) historyPage(res http.ResponseWriter, req *http.Request) {
 	allTxCount, txCountErr := routes.walletMiddleware.TransactionCount(nil)
 	if txCountErr != nil {
 		routes.renderError(fmt.Sprintf("Cannot load history page. "+
-			"Error getting total transaction count: %s", txCountErr.Error()), res)
+			"Error getting total transaction count: %s", txCountErr.Error()), res, req)
 		return
 	}
 
@@ -301,7 +322,7 @@ func (routes *Routes) historyPage(res http.ResponseWriter, req *http.Request) {
 	offset := (int32(pageToLoad) - 1) * txPerPage
 	txns, err := routes.walletMiddleware.TransactionHistory(offset, txPerPage, nil)
 	if err != nil {
-		routes.renderError(fmt.Sprintf("Error fetching history: %s", err.Error()), res)
+		routes.renderError(fmt.Sprintf("Error fetching history: %s", err.Error()), res, req)
 		return
 	}
 
@@ -319,7 +340,7 @@ func (routes *Routes) historyPage(res http.ResponseWriter, req *http.Request) {
 		data["nextPage"] = int(pageToLoad + 1)
 	}
 
-	routes.renderPage("history.html", data, res)
+	routes.renderPage("history.html", data, res, req)
 }
 
 func (routes *Routes) getNextHistoryPage(res http.ResponseWriter, req *http.Request) {
@@ -376,44 +397,81 @@ func (routes *Routes) transactionDetailsPage(res http.ResponseWriter, req *http.
 	tx, err := routes.walletMiddleware.GetTransaction(hash)
 
 	if err != nil {
-		routes.renderError(fmt.Sprintf("Error fetching transaction: %s", err.Error()), res)
+		routes.renderError(fmt.Sprintf("Error fetching transaction: %s", err.Error()), res, req)
 		return
 	}
 
-	// parse tx outputs accounts
-	outputsAccountNames := make([]string, len(tx.Outputs))
-	for i, txOut := range tx.Outputs {
-		accountForOutputAddress, err := routes.walletMiddleware.AddressInfo(txOut.Address)
-		if err != nil || !accountForOutputAddress.IsMine {
-			outputsAccountNames[i] = "external"
-		} else {
-			outputsAccountNames[i] = accountForOutputAddress.AccountName
-		}
+	data := map[string]interface{}{
+		"tx":      tx,
+		"txSize":  fmt.Sprintf("%.1f kB", float64(tx.Size)/1000),
+		"feeRate": dcrutil.Amount(tx.FeeRate).String(),
+		// unmined transactions sent from this wallet can have their fees bumped or be abandoned
+		"canBumpFee": tx.Confirmations == 0 && tx.Direction != txhelper.TransactionDirectionReceived,
+		// suggest double the current fee rate, which is usually enough to get a stuck transaction mined
+		"suggestedFeeRate": dcrutil.Amount(tx.FeeRate * 2).ToCoin(),
+	}
+	routes.renderPage("transaction_details.html", data, res, req)
+}
+
+func (routes *Routes) bumpTransactionFee(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	feeRateDcr, err := strconv.ParseFloat(req.FormValue("fee-rate"), 64)
+	if err != nil || feeRateDcr <= 0 {
+		data["error"] = "Invalid fee rate"
+		return
+	}
+	feeRate, err := dcrutil.NewAmount(feeRateDcr)
+	if err != nil {
+		data["error"] = fmt.Sprintf("Invalid fee rate: %s", err.Error())
+		return
 	}
 
-	data := map[string]interface{}{
-		"tx":                  tx,
-		"outputsAccountNames": outputsAccountNames,
+	passphrase := req.FormValue("passphrase")
+	if passphrase == "" {
+		data["error"] = "The spending passphrase is required"
+		return
+	}
+
+	txHash, err := routes.walletMiddleware.BumpTransactionFee(routes.ctx, chi.URLParam(req, "hash"), int64(feeRate), passphrase)
+	if err != nil {
+		data["error"] = err.Error()
+		return
 	}
-	routes.renderPage("transaction_details.html", data, res)
+
+	data["success"] = true
+	data["txHash"] = txHash
+}
+
+func (routes *Routes) abandonTransaction(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	err := routes.walletMiddleware.AbandonTransaction(routes.ctx, chi.URLParam(req, "hash"))
+	if err != nil {
+		data["error"] = err.Error()
+		return
+	}
+	data["success"] = true
 }
 
 func (routes *Routes) stakingPage(res http.ResponseWriter, req *http.Request) {
 	stakeInfo, err := routes.walletMiddleware.StakeInfo(routes.ctx)
 	if err != nil {
-		routes.renderError(fmt.Sprintf("Error fetching stake info: %s", err.Error()), res)
+		routes.renderError(fmt.Sprintf("Error fetching stake info: %s", err.Error()), res, req)
 		return
 	}
 
 	accounts, err := routes.walletMiddleware.AccountsOverview(walletcore.DefaultRequiredConfirmations)
 	if err != nil {
-		routes.renderError(fmt.Sprintf("Error fetching accounts: %s", err.Error()), res)
+		routes.renderError(fmt.Sprintf("Error fetching accounts: %s", err.Error()), res, req)
 		return
 	}
 
 	ticketPrice, err := routes.walletMiddleware.TicketPrice(routes.ctx)
 	if err != nil {
-		routes.renderError(fmt.Sprintf("Error fetching ticket price: %s", err.Error()), res)
+		routes.renderError(fmt.Sprintf("Error fetching ticket price: %s", err.Error()), res, req)
 		return
 	}
 
@@ -422,8 +480,17 @@ func (routes *Routes) stakingPage(res http.ResponseWriter, req *http.Request) {
 		"accounts":              accounts,
 		"ticketPrice":           dcrutil.Amount(ticketPrice).ToCoin(),
 		"spendUnconfirmedFunds": routes.settings.SpendUnconfirmed,
+		"vspConfig":             vsp.ConfigFromSettings(routes.settings),
 	}
-	routes.renderPage("staking.html", data, res)
+
+	// vote choices are only shown in their own section, so the rest of the page is still useful if they can't be fetched
+	agendas, err := routes.walletMiddleware.VoteChoices(routes.ctx)
+	if err != nil {
+		data["loadVoteChoicesErr"] = fmt.Sprintf("Error fetching vote choices: %s", err.Error())
+	}
+	data["agendas"] = agendas
+
+	routes.renderPage("staking.html", data, res, req)
 }
 
 func (routes *Routes) submitPurchaseTicketsForm(res http.ResponseWriter, req *http.Request) {
@@ -435,6 +502,7 @@ func (routes *Routes) submitPurchaseTicketsForm(res http.ResponseWriter, req *ht
 	numTicketsStr := req.FormValue("number-of-tickets")
 	sourceAccountStr := req.FormValue("source-account")
 	spendUnconfirmed := req.FormValue("spend-unconfirmed")
+	useVSP := req.FormValue("use-vsp")
 
 	numTickets, err := strconv.ParseUint(numTicketsStr, 10, 32)
 	if err != nil {
@@ -462,6 +530,16 @@ func (routes *Routes) submitPurchaseTicketsForm(res http.ResponseWriter, req *ht
 		Account:               uint32(sourceAccount),
 	}
 
+	if useVSP != "" {
+		vspConfig := vsp.ConfigFromSettings(routes.settings)
+		if vspConfig == nil {
+			data["success"] = false
+			data["message"] = "No voting service provider has been configured"
+			return
+		}
+		vspConfig.ApplyToPurchaseRequest(&request)
+	}
+
 	ticketHashes, err := routes.walletMiddleware.PurchaseTicket(routes.ctx, request)
 	if err != nil {
 		data["success"] = false
@@ -481,10 +559,70 @@ func (routes *Routes) submitPurchaseTicketsForm(res http.ResponseWriter, req *ht
 	routes.sendWsBalance()
 }
 
+func (routes *Routes) setVoteChoice(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	req.ParseForm()
+	agendaID := req.FormValue("agenda-id")
+	choiceID := req.FormValue("choice-id")
+	if agendaID == "" || choiceID == "" {
+		data["error"] = "Agenda and vote choice are required"
+		return
+	}
+
+	err := routes.walletMiddleware.SetVoteChoice(routes.ctx, agendaID, choiceID)
+	if err != nil {
+		data["error"] = err.Error()
+		return
+	}
+
+	data["success"] = true
+}
+
+func (routes *Routes) updateVSPConfig(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	req.ParseForm()
+	if req.FormValue("remove") != "" {
+		if err := vsp.SaveConfig(nil, routes.settings); err != nil {
+			data["error"] = err.Error()
+			return
+		}
+		data["success"] = true
+		return
+	}
+
+	walletPassphrase := req.FormValue("wallet-passphrase")
+	if walletPassphrase == "" {
+		data["error"] = "The wallet passphrase is required to import the voting service provider's ticket script"
+		return
+	}
+
+	importScript := func(scriptHex string) error {
+		return routes.walletMiddleware.ImportScript(routes.ctx, scriptHex, walletPassphrase, false, 0)
+	}
+	vspConfig, err := vsp.Setup(req.Context(), req.FormValue("api-url"), req.FormValue("api-key"), routes.walletMiddleware.ValidateAddress, importScript)
+	if err != nil {
+		data["error"] = err.Error()
+		return
+	}
+
+	if err = vsp.SaveConfig(vspConfig, routes.settings); err != nil {
+		data["error"] = err.Error()
+		return
+	}
+
+	data["success"] = true
+	data["host"] = vspConfig.Host()
+	data["poolFees"] = vspConfig.PoolFees
+}
+
 func (routes *Routes) accountsPage(res http.ResponseWriter, req *http.Request) {
 	accounts, err := routes.walletMiddleware.AccountsOverview(walletcore.DefaultRequiredConfirmations)
 	if err != nil {
-		routes.renderError(fmt.Sprintf("Error fetching account balance: %s", err.Error()), res)
+		routes.renderError(fmt.Sprintf("Error fetching account balance: %s", err.Error()), res, req)
 		return
 	}
 
@@ -506,12 +644,153 @@ func (routes *Routes) accountsPage(res http.ResponseWriter, req *http.Request) {
 		"hiddenAccounts": routes.settings.HiddenAccounts,
 		"hdPath":         networkHDPath,
 	}
-	routes.renderPage("accounts.html", data, res)
+	routes.renderPage("accounts.html", data, res, req)
+}
+
+func (routes *Routes) renameAccount(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	accountNumberStr := chi.URLParam(req, "accountNumber")
+	accountNumber, err := strconv.ParseUint(accountNumberStr, 10, 32)
+	if err != nil {
+		data["error"] = fmt.Sprintf("Invalid account selected: %s", accountNumberStr)
+		return
+	}
+
+	req.ParseForm()
+	newName := req.FormValue("new-name")
+	if newName == "" {
+		data["error"] = "The new account name is required"
+		return
+	}
+
+	err = routes.walletMiddleware.RenameAccount(uint32(accountNumber), newName)
+	if err != nil {
+		data["error"] = err.Error()
+		return
+	}
+
+	data["success"] = true
+	data["name"] = newName
+}
+
+func (routes *Routes) accountExtendedPubKey(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	accountNumberStr := chi.URLParam(req, "accountNumber")
+	accountNumber, err := strconv.ParseUint(accountNumberStr, 10, 32)
+	if err != nil {
+		data["error"] = fmt.Sprintf("Invalid account selected: %s", accountNumberStr)
+		return
+	}
+
+	xpub, err := routes.walletMiddleware.AccountExtendedPubKey(uint32(accountNumber))
+	if err != nil {
+		data["error"] = err.Error()
+		return
+	}
+
+	data["success"] = true
+	data["xpub"] = xpub
 }
 
 func (routes *Routes) securityPage(res http.ResponseWriter, req *http.Request) {
+	accounts, err := routes.walletMiddleware.AccountsOverview(walletcore.DefaultRequiredConfirmations)
+	if err != nil {
+		routes.renderError(fmt.Sprintf("Error fetching accounts: %s", err.Error()), res, req)
+		return
+	}
+
+	data := map[string]interface{}{
+		"accounts":         accounts,
+		"sweepUTXOService": routes.settings.SweepUTXOService(routes.walletMiddleware.NetType()),
+	}
+	routes.renderPage("security.html", data, res, req)
+}
+
+func (routes *Routes) submitSweepForm(res http.ResponseWriter, req *http.Request) {
 	data := map[string]interface{}{}
-	routes.renderPage("security.html", data, res)
+	defer renderJSON(data, res)
+
+	req.ParseForm()
+	privateKeyWif := req.FormValue("private-key")
+	destinationAccountStr := req.FormValue("destination-account")
+
+	if privateKeyWif == "" {
+		data["error"] = "The private key to sweep is required"
+		return
+	}
+
+	// the key's address is sent to the lookup link, only sweep if the user agreed to that
+	if req.FormValue("confirm-utxo-lookup") == "" {
+		data["error"] = "Confirm that the address of the private key may be sent to look up its unspent outputs"
+		return
+	}
+
+	destinationAccount, err := strconv.ParseUint(destinationAccountStr, 10, 32)
+	if err != nil {
+		data["error"] = fmt.Sprintf("Invalid account selected: %s", destinationAccountStr)
+		return
+	}
+
+	netType := routes.walletMiddleware.NetType()
+	result, err := sweep.Sweep(routes.ctx, routes.walletMiddleware, privateKeyWif, uint32(destinationAccount), func(address string) string {
+		return routes.settings.SweepUTXOURL(netType, address)
+	})
+	if err != nil {
+		data["error"] = err.Error()
+		return
+	}
+
+	data["success"] = true
+	data["txHash"] = result.TransactionHash
+	data["amount"] = result.TotalAmount.String()
+	data["sourceAddress"] = result.SourceAddress
+}
+
+func (routes *Routes) submitImportForm(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	req.ParseForm()
+	importType := req.FormValue("import-type")
+	importValue := req.FormValue("import-value")
+	walletPassphrase := req.FormValue("wallet-passphrase")
+	rescan := req.FormValue("rescan") != ""
+
+	if importValue == "" {
+		data["error"] = "The private key or script to import is required"
+		return
+	}
+
+	var rescanFromHeight int32
+	if rescanFromHeightStr := req.FormValue("rescan-from-height"); rescan && rescanFromHeightStr != "" {
+		height, err := strconv.ParseInt(rescanFromHeightStr, 10, 32)
+		if err != nil || height < 0 {
+			data["error"] = "Invalid rescan height"
+			return
+		}
+		rescanFromHeight = int32(height)
+	}
+
+	var err error
+	switch importType {
+	case "private-key":
+		err = routes.walletMiddleware.ImportPrivateKey(routes.ctx, importValue, walletPassphrase, rescan, rescanFromHeight)
+	case "script":
+		err = routes.walletMiddleware.ImportScript(routes.ctx, importValue, walletPassphrase, rescan, rescanFromHeight)
+	default:
+		err = fmt.Errorf("Invalid import type: %s", importType)
+	}
+	if err != nil {
+		data["error"] = err.Error()
+		return
+	}
+
+	data["success"] = true
+	routes.sendWsBalance()
 }
 
 func (routes *Routes) settingsPage(res http.ResponseWriter, req *http.Request) {
@@ -520,8 +799,31 @@ func (routes *Routes) settingsPage(res http.ResponseWriter, req *http.Request) {
 		"showIncomingTransactionNotification": routes.settings.ShowIncomingTransactionNotification,
 		"showNewBlockNotification":            routes.settings.ShowNewBlockNotification,
 		"currencyConverter":                   routes.settings.CurrencyConverter,
+		"spvConnect":                          strings.Join(routes.settings.SPVConnect, "\n"),
+		"logLevels":                           logging.Levels,
+	}
+
+	type subsystemLogLevel struct {
+		Subsystem string
+		Level     string
+	}
+	subsystemLevels := logging.SubsystemLevels()
+	logSubsystems := make([]subsystemLogLevel, 0, len(subsystemLevels))
+	for _, subsystemID := range logging.Subsystems() {
+		logSubsystems = append(logSubsystems, subsystemLogLevel{subsystemID, subsystemLevels[subsystemID]})
+	}
+	data["logSubsystems"] = logSubsystems
+
+	connectionInfo, err := routes.walletMiddleware.WalletConnectionInfo()
+	if err != nil {
+		data["loadPeersError"] = fmt.Sprintf("Error fetching connected peers: %s", err.Error())
+	} else {
+		data["peersConnected"] = connectionInfo.PeersConnected
+		data["peerAddresses"] = connectionInfo.PeerAddresses
+		data["configuredPeers"] = connectionInfo.ConfiguredPeers
 	}
-	routes.renderPage("settings.html", data, res)
+
+	routes.renderPage("settings.html", data, res, req)
 }
 
 func (routes *Routes) changeSpendingPassword(res http.ResponseWriter, req *http.Request) {
@@ -639,6 +941,47 @@ func (routes *Routes) updateSetting(res http.ResponseWriter, req *http.Request)
 		data["success"] = true
 	}
 
+	// an empty spv-connect value removes all peers, so check if the field was sent rather than if it has a value
+	if _, setSPVConnect := req.Form["spv-connect"]; setSPVConnect {
+		spvConnect, err := config.ParsePeerAddresses(req.FormValue("spv-connect"))
+		if err != nil {
+			data["error"] = fmt.Sprintf("Error updating settings. %s", err.Error())
+			return
+		}
+
+		err = config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+			cnfg.SPVConnect = spvConnect
+		})
+		if err != nil {
+			data["error"] = fmt.Sprintf("Error updating settings. %s", err.Error())
+			return
+		}
+		routes.settings.SPVConnect = spvConnect
+		data["success"] = true
+	}
+
+	// log levels are changed immediately and saved as debuglevel in config so they are kept when godcr is restarted
+	if logLevel := req.FormValue("log-level"); logLevel != "" {
+		levelParts := strings.SplitN(logLevel, "=", 2)
+		if len(levelParts) != 2 {
+			data["error"] = "Invalid value for 'log level' setting"
+			return
+		}
+		if err := logging.SetLevel(levelParts[0], levelParts[1]); err != nil {
+			data["error"] = fmt.Sprintf("Error updating settings. %s", err.Error())
+			return
+		}
+
+		err := config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+			cnfg.DebugLevel = logging.DebugLevel()
+		})
+		if err != nil {
+			data["error"] = fmt.Sprintf("Error updating settings. %s", err.Error())
+			return
+		}
+		data["success"] = true
+	}
+
 	if accountToBeHidden := req.FormValue("hide-account"); accountToBeHidden != "" {
 		accountInt, err := strconv.Atoi(accountToBeHidden)
 		if err != nil {
@@ -701,12 +1044,26 @@ func (routes *Routes) updateSetting(res http.ResponseWriter, req *http.Request)
 }
 
 func (routes *Routes) rescanBlockchain(res http.ResponseWriter, req *http.Request) {
-	err := routes.walletMiddleware.RescanBlockChain()
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	req.ParseForm()
+	var fromHeight int32
+	if fromHeightStr := req.FormValue("from-height"); fromHeightStr != "" {
+		height, err := strconv.ParseInt(fromHeightStr, 10, 32)
+		if err != nil {
+			data["error"] = "Invalid rescan height"
+			return
+		}
+		fromHeight = int32(height)
+	}
+
+	err := routes.walletMiddleware.RescanBlockChain(fromHeight)
 	if err != nil {
-		renderJSON(map[string]interface{}{"error": err.Error()}, res)
-	} else {
-		renderJSON(map[string]interface{}{"success": true}, res)
+		data["error"] = err.Error()
+		return
 	}
+	data["success"] = true
 }
 
 func (routes *Routes) deleteWallet(res http.ResponseWriter, req *http.Request) {
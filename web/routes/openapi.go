@@ -0,0 +1,176 @@
+package routes
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// openAPIDescription generates an OpenAPI 3 description of the api from the api endpoints.
+// Request and response schemas are derived from the go types used by each endpoint,
+// so the description always matches what the handlers accept and return.
+func openAPIDescription(endpoints []*apiEndpoint) map[string]interface{} {
+	paths := map[string]interface{}{}
+	for _, endpoint := range endpoints {
+		pathItem, ok := paths[endpoint.path].(map[string]interface{})
+		if !ok {
+			pathItem = map[string]interface{}{}
+			paths[endpoint.path] = pathItem
+		}
+		pathItem[strings.ToLower(endpoint.method)] = openAPIOperation(endpoint)
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.0",
+		"info": map[string]interface{}{
+			"title":   "godcr wallet api",
+			"version": "1.0.0",
+		},
+		"servers": []map[string]interface{}{
+			{"url": apiBasePath},
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": map[string]interface{}{
+				"Error": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"error": jsonSchema(reflect.TypeOf(apiError{})),
+					},
+				},
+			},
+		},
+	}
+}
+
+func openAPIOperation(endpoint *apiEndpoint) map[string]interface{} {
+	errorResponse := map[string]interface{}{
+		"description": "Error",
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": map[string]interface{}{"$ref": "#/components/schemas/Error"},
+			},
+		},
+	}
+
+	operation := map[string]interface{}{
+		"summary": endpoint.summary,
+		"responses": map[string]interface{}{
+			"200": map[string]interface{}{
+				"description": "Success",
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"data": jsonSchema(reflect.TypeOf(endpoint.response)),
+							},
+						},
+					},
+				},
+			},
+			"default": errorResponse,
+		},
+	}
+
+	if len(endpoint.params) > 0 {
+		parameters := make([]map[string]interface{}, len(endpoint.params))
+		for i, param := range endpoint.params {
+			parameters[i] = map[string]interface{}{
+				"name":        param.name,
+				"in":          param.in,
+				"required":    param.in == "path",
+				"description": param.description,
+				"schema":      map[string]interface{}{"type": param.paramType},
+			}
+		}
+		operation["parameters"] = parameters
+	}
+
+	if endpoint.requestBody != nil {
+		operation["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": jsonSchema(reflect.TypeOf(endpoint.requestBody)),
+				},
+			},
+		}
+	}
+
+	if endpoint.method == http.MethodPost {
+		operation["description"] = "Expects a json request body"
+	}
+
+	return operation
+}
+
+// jsonSchema returns the json schema for values of type `t` as encoded by encoding/json
+func jsonSchema(t reflect.Type) map[string]interface{} {
+	if t == nil {
+		return map[string]interface{}{}
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// byte slices are encoded as base64 strings
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": jsonSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": jsonSchema(t.Elem())}
+	case reflect.Struct:
+		properties := map[string]interface{}{}
+		addStructFields(t, properties)
+		return map[string]interface{}{"type": "object", "properties": properties}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+func addStructFields(t reflect.Type, properties map[string]interface{}) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		jsonTag := field.Tag.Get("json")
+		if jsonTag == "-" {
+			continue
+		}
+		name := strings.Split(jsonTag, ",")[0]
+
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				addStructFields(fieldType, properties)
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			// unexported field
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = jsonSchema(field.Type)
+	}
+}
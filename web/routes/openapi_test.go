@@ -0,0 +1,51 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
+)
+
+// TestOpenAPIDescribesAllAPIRoutes checks that every route registered under the api base path is described in openapi.json
+func TestOpenAPIDescribesAllAPIRoutes(t *testing.T) {
+	routes := &Routes{syncProgressReport: defaultsynclistener.InitProgressReport()}
+	router := chi.NewRouter()
+	router.Route(apiBasePath, routes.registerAPIRoutes)
+
+	res := httptest.NewRecorder()
+	router.ServeHTTP(res, httptest.NewRequest(http.MethodGet, apiBasePath+"/openapi.json", nil))
+	if res.Code != http.StatusOK {
+		t.Fatalf("expected status %d for openapi.json, got %d", http.StatusOK, res.Code)
+	}
+
+	var description struct {
+		Paths map[string]map[string]interface{} `json:"paths"`
+	}
+	if err := json.Unmarshal(res.Body.Bytes(), &description); err != nil {
+		t.Fatalf("error decoding openapi.json: %v", err)
+	}
+
+	walkFunc := func(method string, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		if !strings.HasPrefix(route, apiBasePath) {
+			return nil
+		}
+
+		path := strings.TrimPrefix(route, apiBasePath)
+		if path == "/openapi.json" {
+			return nil
+		}
+
+		if _, ok := description.Paths[path][strings.ToLower(method)]; !ok {
+			t.Errorf("%s %s is not described in openapi.json", method, route)
+		}
+		return nil
+	}
+	if err := chi.Walk(router, walkFunc); err != nil {
+		t.Fatalf("error walking routes: %v", err)
+	}
+}
@@ -8,12 +8,13 @@ import (
 	"github.com/raedahgroup/godcr/web/weblog"
 )
 
-func (routes *Routes) renderPage(tplName string, data map[string]interface{}, res http.ResponseWriter) {
+func (routes *Routes) renderPage(tplName string, data map[string]interface{}, res http.ResponseWriter, req *http.Request) {
 	connectionInfo, err := routes.walletMiddleware.WalletConnectionInfo()
 	if err != nil {
 		weblog.LogError(err)
 	}
 	data["connectionInfo"] = connectionInfo
+	data["csrfToken"] = csrfToken(req)
 	routes.render(tplName, data, res)
 }
 
@@ -29,15 +30,16 @@ func (routes *Routes) render(tplName string, data interface{}, res http.Response
 	log.Fatalf("template %s is not registered", tplName)
 }
 
-func (routes *Routes) renderSyncPage(syncInfo map[string]interface{}, res http.ResponseWriter) {
+func (routes *Routes) renderSyncPage(syncInfo map[string]interface{}, res http.ResponseWriter, req *http.Request) {
+	syncInfo["csrfToken"] = csrfToken(req)
 	routes.render("sync.html", syncInfo, res)
 }
 
-func (routes *Routes) renderError(errorMessage string, res http.ResponseWriter) {
+func (routes *Routes) renderError(errorMessage string, res http.ResponseWriter, req *http.Request) {
 	data := map[string]interface{}{
 		"error": errorMessage,
 	}
-	routes.renderPage("error.html", data, res)
+	routes.renderPage("error.html", data, res, req)
 }
 
 func (routes *Routes) renderNoWalletError(res http.ResponseWriter) {
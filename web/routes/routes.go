@@ -3,7 +3,9 @@ package routes
 import (
 	"context"
 	"html/template"
+	"io/fs"
 	"log"
+	"sync"
 
 	"github.com/go-chi/chi"
 	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
@@ -15,15 +17,37 @@ import (
 type Routes struct {
 	walletMiddleware app.WalletMiddleware
 	//walletExists       bool
-	templates          map[string]*template.Template
+	templates map[string]*template.Template
+	ctx       context.Context
+	settings  *config.Settings
+	auth      *authenticator
+	wsHub     *wsHub
+
+	// syncMu guards syncProgressReport and lastSyncPhase, which are updated by the sync progress callback
+	// while requests are being served
+	syncMu             sync.RWMutex
 	syncProgressReport *defaultsynclistener.ProgressReport
-	ctx                context.Context
-	settings           *config.Settings
+	lastSyncPhase      syncPhase
+}
+
+// syncPhase is sent to websocket clients when the blockchain sync status or step changes
+type syncPhase struct {
+	Status      defaultsynclistener.SyncStatus `json:"status"`
+	CurrentStep int32                          `json:"currentStep"`
+	Done        bool                           `json:"done"`
+}
+
+// syncProgress returns the last sync progress report
+func (routes *Routes) syncProgress() *defaultsynclistener.ProgressReport {
+	routes.syncMu.RLock()
+	defer routes.syncMu.RUnlock()
+	return routes.syncProgressReport
 }
 
 // OpenWalletAndSetupRoutes attempts to open the wallet, prepares page templates and creates route handlers
 // returns syncBlockChain function
-func OpenWalletAndSetupRoutes(ctx context.Context, walletMiddleware app.WalletMiddleware, router chi.Router, settings *config.Settings) (func(), error) {
+func OpenWalletAndSetupRoutes(ctx context.Context, walletMiddleware app.WalletMiddleware, router chi.Router,
+	assets fs.FS, authConfig AuthConfig, settings *config.Settings) (func(), error) {
 	// todo: main.go now requires that the user select a wallet or create one before launching interfaces, so need for this check
 	//walletExists, err := walletMiddleware.WalletExists()
 	//if err != nil {
@@ -36,20 +60,32 @@ func OpenWalletAndSetupRoutes(ctx context.Context, walletMiddleware app.WalletMi
 		ctx:                ctx,
 		//walletExists:       walletExists,
 		settings: settings,
+		auth:     newAuthenticator(authConfig),
+		wsHub:    newWsHub(),
 	}
 
-	routes.loadTemplates()
+	go func() {
+		<-ctx.Done()
+		routes.wsHub.close()
+	}()
+
+	// push wallet changes and chain reorganisations to websocket clients for as long as the server runs
+	go routes.watchWalletChanges()
+	walletMiddleware.SetChainReorgListener(routes.sendWsChainReorg)
+
+	routes.loadTemplates(assets)
 	routes.loadRoutes(router)
 
 	return routes.syncBlockChain, nil
 }
 
-func (routes *Routes) loadTemplates() {
-	layout := "web/views/layout.html"
-	utils := "web/views/utils.html"
+// loadTemplates parses page templates from the views directory of `assets`
+func (routes *Routes) loadTemplates(assets fs.FS) {
+	layout := "views/layout.html"
+	utils := "views/utils.html"
 
 	for _, tmpl := range templates() {
-		parsedTemplate, err := template.New(tmpl.name).Funcs(templateFuncMap()).ParseFiles(tmpl.path, layout, utils)
+		parsedTemplate, err := template.New(tmpl.name).Funcs(templateFuncMap()).Funcs(routes.authTemplateFuncMap()).Funcs(routes.explorerTemplateFuncMap()).ParseFS(assets, tmpl.path, layout, utils)
 		if err != nil {
 			log.Fatalf("error loading templates: %s", err.Error())
 		}
@@ -59,17 +95,37 @@ func (routes *Routes) loadTemplates() {
 }
 
 func (routes *Routes) loadRoutes(router chi.Router) {
-	// todo: main.go now requires that the user select a wallet or create one before launching interfaces, so need for this code
-	//router.Get("/createwallet", routes.createWalletPage)
-	//router.Post("/createwallet", routes.createWallet)
+	router.Group(func(router chi.Router) {
+		// state-changing requests to any of the following routes must include a valid csrf token
+		router.Use(routes.csrfMiddleware)
+
+		// todo: main.go now requires that the user select a wallet or create one before launching interfaces, so need for this code
+		//router.Get("/createwallet", routes.createWalletPage)
+		//router.Post("/createwallet", routes.createWallet)
+		router.Get("/login", routes.loginPage)
+		router.Post("/login", routes.submitLoginForm)
+
+		// every other route requires the user to be logged in if authentication is enabled
+		router.Group(routes.registerRoutesRequiringAuthentication)
+	})
+}
+
+func (routes *Routes) registerRoutesRequiringAuthentication(router chi.Router) {
+	router.Use(routes.authenticationMiddleware)
+
+	router.Post("/logout", routes.logout)
 	router.Get("/settings", routes.settingsPage)
 	router.Post("/change-password", routes.changeSpendingPassword)
 	router.Put("/settings", routes.updateSetting)
 	router.Post("/rescan-blockchain", routes.rescanBlockchain)
+	router.Post("/cancel-sync", routes.cancelSync)
+	router.Post("/restart-sync", routes.restartSync)
 	router.Delete("/delete-wallet", routes.deleteWallet)
 
 	router.Get("/ws", routes.wsHandler)
-	go routes.waitToSendMessagesToClients()
+
+	// versioned json api, checks wallet and sync status itself and responds with json errors instead of html pages
+	router.Route(apiBasePath, routes.registerAPIRoutes)
 
 	// use router group for routes that require wallet to be loaded before being accessed
 	router.Group(routes.registerRoutesRequiringWallet)
@@ -91,8 +147,17 @@ func (routes *Routes) registerRoutesRequiringWallet(router chi.Router) {
 	router.Get("/history", routes.historyPage)
 	router.Get("/next-history-page", routes.getNextHistoryPage)
 	router.Get("/transaction-details/{hash}", routes.transactionDetailsPage)
+	router.Post("/transaction-details/{hash}/bump-fee", routes.bumpTransactionFee)
+	router.Post("/transaction-details/{hash}/abandon", routes.abandonTransaction)
+	router.Post("/rebroadcast-unmined", routes.rebroadcastUnminedTransactions)
 	router.Get("/staking", routes.stakingPage)
 	router.Post("/purchase-tickets", routes.submitPurchaseTicketsForm)
+	router.Post("/vsp-config", routes.updateVSPConfig)
+	router.Post("/vote-choice", routes.setVoteChoice)
 	router.Get("/accounts", routes.accountsPage)
+	router.Post("/rename-account/{accountNumber}", routes.renameAccount)
+	router.Get("/account-xpub/{accountNumber}", routes.accountExtendedPubKey)
 	router.Get("/security", routes.securityPage)
+	router.Post("/import", routes.submitImportForm)
+	router.Post("/sweep", routes.submitSweepForm)
 }
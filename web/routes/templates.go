@@ -20,18 +20,19 @@ type templateData struct {
 
 func templates() []templateData {
 	return []templateData{
-		{"error.html", "web/views/error.html"},
-		{"createwallet.html", "web/views/createwallet.html"},
-		{"overview.html", "web/views/overview.html"},
-		{"sync.html", "web/views/sync.html"},
-		{"send.html", "web/views/send.html"},
-		{"receive.html", "web/views/receive.html"},
-		{"history.html", "web/views/history.html"},
-		{"transaction_details.html", "web/views/transaction_details.html"},
-		{"staking.html", "web/views/staking.html"},
-		{"accounts.html", "web/views/accounts.html"},
-		{"security.html", "web/views/security.html"},
-		{"settings.html", "web/views/settings.html"},
+		{"error.html", "views/error.html"},
+		{"login.html", "views/login.html"},
+		{"createwallet.html", "views/createwallet.html"},
+		{"overview.html", "views/overview.html"},
+		{"sync.html", "views/sync.html"},
+		{"send.html", "views/send.html"},
+		{"receive.html", "views/receive.html"},
+		{"history.html", "views/history.html"},
+		{"transaction_details.html", "views/transaction_details.html"},
+		{"staking.html", "views/staking.html"},
+		{"accounts.html", "views/accounts.html"},
+		{"security.html", "views/security.html"},
+		{"settings.html", "views/settings.html"},
 	}
 }
 
@@ -111,3 +112,15 @@ func templateFuncMap() template.FuncMap {
 		},
 	}
 }
+
+// explorerTemplateFuncMap returns functions for building block explorer links for the network of the open wallet
+func (routes *Routes) explorerTemplateFuncMap() template.FuncMap {
+	return template.FuncMap{
+		"explorerTxURL": func(txHash string) string {
+			return routes.settings.ExplorerTxURL(routes.walletMiddleware.NetType(), txHash)
+		},
+		"explorerAddressURL": func(address string) string {
+			return routes.settings.ExplorerAddressURL(routes.walletMiddleware.NetType(), address)
+		},
+	}
+}
@@ -26,7 +26,7 @@ func (routes *Routes) walletLoaderFn(next http.Handler) http.Handler {
 		var errMsg string
 		defer func() {
 			if errMsg != "" {
-				routes.renderError(errMsg, res)
+				routes.renderError(errMsg, res, req)
 			}
 		}()
 
@@ -42,7 +42,7 @@ func (routes *Routes) walletLoaderFn(next http.Handler) http.Handler {
 		}
 
 		// wallet is open, check if blockchain is synced
-		syncProgressReport := routes.syncProgressReport.Read()
+		syncProgressReport := routes.syncProgress().Read()
 
 		if syncProgressReport.Done {
 			// ignore any other reported sync progress
@@ -53,15 +53,14 @@ func (routes *Routes) walletLoaderFn(next http.Handler) http.Handler {
 		switch syncProgressReport.Status {
 		case defaultsynclistener.SyncStatusSuccess:
 			next.ServeHTTP(res, req)
-		case defaultsynclistener.SyncStatusInProgress:
+		case defaultsynclistener.SyncStatusInProgress, defaultsynclistener.SyncStatusError:
+			// sync page shows sync errors and allows the user to restart the sync
 			syncInfoMap, err := routes.prepareSyncInfoMap()
 			if err != nil {
 				errMsg = fmt.Sprintf("Cannot load sync progress page: %s", err.Error())
 			} else {
-				routes.renderSyncPage(syncInfoMap, res)
+				routes.renderSyncPage(syncInfoMap, res, req)
 			}
-		case defaultsynclistener.SyncStatusError:
-			errMsg = fmt.Sprintf("Cannot display page. Following error occured during sync: %s", syncProgressReport.Error)
 		default:
 			errMsg = "Cannot display page. Blockchain sync status cannot be determined"
 		}
@@ -69,15 +68,42 @@ func (routes *Routes) walletLoaderFn(next http.Handler) http.Handler {
 }
 
 func (routes *Routes) syncBlockChain() {
-	routes.walletMiddleware.SyncBlockChain(false, func(report *defaultsynclistener.ProgressReport) {
+	routes.walletMiddleware.SyncBlockChain(routes.ctx, false, func(report *defaultsynclistener.ProgressReport) {
+		routes.syncMu.Lock()
 		routes.syncProgressReport = report
+		routes.syncMu.Unlock()
+
 		routes.sendWsSyncProgress()
+		routes.sendWsSyncPhaseChange(report)
 		routes.sendWsConnectionInfoUpdate()
 	})
 }
 
+func (routes *Routes) cancelSync(res http.ResponseWriter, req *http.Request) {
+	routes.walletMiddleware.CancelSync()
+	renderJSON(map[string]interface{}{"success": true}, res)
+}
+
+func (routes *Routes) restartSync(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	err := routes.walletMiddleware.RestartSync()
+	if err != nil {
+		data["error"] = fmt.Sprintf("Error restarting sync: %s", err.Error())
+		return
+	}
+	data["success"] = true
+}
+
+// blockchainSynced returns true if the last sync progress report shows that the blockchain is synced
+func (routes *Routes) blockchainSynced() bool {
+	syncProgressReport := routes.syncProgress().Read()
+	return syncProgressReport.Done || syncProgressReport.Status == defaultsynclistener.SyncStatusSuccess
+}
+
 func (routes *Routes) prepareSyncInfoMap() (map[string]interface{}, error) {
-	syncInfo := routes.syncProgressReport.Read()
+	syncInfo := routes.syncProgress().Read()
 	var syncInfoMap map[string]interface{}
 
 	syncInfoBytes, _ := json.Marshal(syncInfo)
@@ -90,6 +116,8 @@ func (routes *Routes) prepareSyncInfoMap() (map[string]interface{}, error) {
 	}
 
 	syncInfoMap["networkType"] = routes.walletMiddleware.NetType()
+	syncInfoMap["syncFailed"] = syncInfo.Status == defaultsynclistener.SyncStatusError
+	syncInfoMap["syncError"] = syncInfo.Error
 
 	if syncInfo.CurrentStep == defaultsynclistener.DiscoveringUsedAddresses {
 		// check account discovery progress percentage
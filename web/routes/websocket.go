@@ -2,31 +2,51 @@ package routes
 
 import (
 	"fmt"
-	"log"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/gorilla/websocket"
+	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/web/weblog"
 )
 
-var clients = make(map[*websocket.Conn]bool)
-var wsBroadcast = make(chan Packet)
-
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
+	CheckOrigin:     checkWebsocketOrigin,
 }
 
+// how often to check for new transactions and ticket status changes
+const walletChangesCheckInterval = 10 * time.Second
+
 type eventType string
 
 const (
 	updateConnectionInfo eventType = "updateConnInfo"
 	updateBalance        eventType = "updateBalance"
 	updateSyncProgress   eventType = "updateSyncProgress"
+	syncPhaseChanged     eventType = "syncPhaseChanged"
+	newTransaction       eventType = "newTransaction"
+	ticketStatusChanged  eventType = "ticketStatusChanged"
+	chainReorg           eventType = "chainReorg"
 )
 
+// allEvents lists the events that clients can subscribe to
+var allEvents = []eventType{
+	updateConnectionInfo,
+	updateBalance,
+	updateSyncProgress,
+	syncPhaseChanged,
+	newTransaction,
+	ticketStatusChanged,
+	chainReorg,
+}
+
 type Packet struct {
 	Event   eventType   `json:"event"`
 	Message interface{} `json:"message"`
@@ -35,29 +55,27 @@ type Packet struct {
 func (routes *Routes) wsHandler(w http.ResponseWriter, r *http.Request) {
 	ws, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
-		log.Fatal(err)
+		// upgrader has already sent an error response to the client
+		weblog.LogError(fmt.Errorf("ws upgrade error: %s", err.Error()))
+		return
 	}
 
-	clients[ws] = true
+	routes.wsHub.addClient(ws)
 }
 
-func (routes *Routes) waitToSendMessagesToClients() {
-	if routes.ctx.Err() != nil {
-		// user must have hit ctrl+c to shutdown the web server
-		return
+// checkWebsocketOrigin rejects websocket connections opened by pages served from other sites.
+// Requests without an Origin header are not sent by browsers and are allowed.
+func checkWebsocketOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
 	}
 
-	for {
-		msg := <-wsBroadcast
-		for client := range clients {
-			err := client.WriteJSON(msg)
-			if err != nil {
-				weblog.LogError(fmt.Errorf("ws update error: %s", err.Error()))
-				client.Close()
-				delete(clients, client)
-			}
-		}
+	originURL, err := url.Parse(origin)
+	if err != nil {
+		return false
 	}
+	return strings.EqualFold(originURL.Host, r.Host)
 }
 
 func (routes *Routes) sendWsConnectionInfoUpdate() {
@@ -71,10 +89,10 @@ func (routes *Routes) sendWsConnectionInfoUpdate() {
 		weblog.LogError(err)
 	}
 
-	wsBroadcast <- Packet{
+	routes.wsHub.broadcast(Packet{
 		Event:   updateConnectionInfo,
 		Message: info,
-	}
+	})
 }
 
 func (routes *Routes) sendWsBalance() {
@@ -100,10 +118,10 @@ func (routes *Routes) sendWsBalance() {
 		totalBalance += acc.Balance.Total
 		accountInfos = append(accountInfos, accountInfo{Number: acc.Number, Info: acc.String()})
 	}
-	wsBroadcast <- Packet{
+	routes.wsHub.broadcast(Packet{
 		Event:   updateBalance,
 		Message: map[string]interface{}{"accounts": accountInfos, "total": totalBalance.String()},
-	}
+	})
 }
 
 func (routes *Routes) sendWsSyncProgress() {
@@ -113,8 +131,143 @@ func (routes *Routes) sendWsSyncProgress() {
 		return
 	}
 
-	wsBroadcast <- Packet{
+	routes.wsHub.broadcast(Packet{
 		Event:   updateSyncProgress,
 		Message: syncInfo,
+	})
+}
+
+// sendWsSyncPhaseChange notifies clients when the sync status or current sync step changes,
+// so clients that do not need every progress update can still follow the sync.
+func (routes *Routes) sendWsSyncPhaseChange(report *defaultsynclistener.ProgressReport) {
+	syncInfo := report.Read()
+	phase := syncPhase{
+		Status:      syncInfo.Status,
+		CurrentStep: syncInfo.CurrentStep,
+		Done:        syncInfo.Done,
+	}
+
+	routes.syncMu.Lock()
+	phaseChanged := phase != routes.lastSyncPhase
+	routes.lastSyncPhase = phase
+	routes.syncMu.Unlock()
+	if !phaseChanged {
+		return
+	}
+
+	routes.wsHub.broadcast(Packet{
+		Event:   syncPhaseChanged,
+		Message: phase,
+	})
+}
+
+// watchWalletChanges periodically checks for changes in the state of the connection to dcrwallet and,
+// once the blockchain is synced, for new transactions and ticket status changes, and notifies clients of any changes. Changes are polled for because not all wallet mediums provide notifications for them.
+func (routes *Routes) watchWalletChanges() {
+	ticker := time.NewTicker(walletChangesCheckInterval)
+	defer ticker.Stop()
+
+	lastTxCount := -1
+	var lastStakeInfo *walletcore.StakeInfo
+	var lastWalletRPCState string
+
+	for {
+		select {
+		case <-routes.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		lastWalletRPCState = routes.sendWsWalletRPCStateChange(lastWalletRPCState)
+
+		if !routes.walletMiddleware.IsWalletOpen() || !routes.blockchainSynced() {
+			continue
+		}
+
+		lastTxCount = routes.sendWsNewTransactions(lastTxCount)
+		lastStakeInfo = routes.sendWsTicketStatusChange(lastStakeInfo)
+	}
+}
+
+// sendWsWalletRPCStateChange sends the wallet connection info if the state of the connection to dcrwallet
+// differs from `lastState` and returns the current state.
+func (routes *Routes) sendWsWalletRPCStateChange(lastState string) string {
+	info, err := routes.walletMiddleware.WalletConnectionInfo()
+	if err != nil && info.WalletRPCState == "" {
+		return lastState
+	}
+
+	if info.WalletRPCState != lastState {
+		routes.wsHub.broadcast(Packet{
+			Event:   updateConnectionInfo,
+			Message: info,
+		})
+	}
+	return info.WalletRPCState
+}
+
+// sendWsNewTransactions sends the transactions added to the wallet since the transaction count was `lastTxCount`
+// and returns the current transaction count. No transactions are sent if `lastTxCount` is negative.
+func (routes *Routes) sendWsNewTransactions(lastTxCount int) int {
+	txCount, err := routes.walletMiddleware.TransactionCount(nil)
+	if err != nil {
+		weblog.LogError(fmt.Errorf("error checking for new transactions: %s", err.Error()))
+		return lastTxCount
+	}
+
+	if lastTxCount < 0 || txCount <= lastTxCount {
+		return txCount
+	}
+
+	// transaction history is ordered from the most recent transaction
+	txs, err := routes.walletMiddleware.TransactionHistory(0, int32(txCount-lastTxCount), nil)
+	if err != nil {
+		weblog.LogError(fmt.Errorf("error fetching new transactions: %s", err.Error()))
+		return lastTxCount
+	}
+
+	for _, tx := range txs {
+		routes.wsHub.broadcast(Packet{
+			Event: newTransaction,
+			Message: map[string]interface{}{
+				"hash":      tx.Hash,
+				"type":      tx.Type,
+				"direction": tx.Direction.String(),
+				"amount":    dcrutil.Amount(tx.Amount).String(),
+				"notify": routes.settings.ShowIncomingTransactionNotification &&
+					tx.Direction == txhelper.TransactionDirectionReceived,
+			},
+		})
+	}
+
+	routes.sendWsBalance()
+	return txCount
+}
+
+// sendWsTicketStatusChange sends the wallet's stake info if it differs from `lastStakeInfo` and returns the current stake info.
+func (routes *Routes) sendWsTicketStatusChange(lastStakeInfo *walletcore.StakeInfo) *walletcore.StakeInfo {
+	stakeInfo, err := routes.walletMiddleware.StakeInfo(routes.ctx)
+	if err != nil {
+		weblog.LogError(fmt.Errorf("error checking for ticket status changes: %s", err.Error()))
+		return lastStakeInfo
+	}
+
+	if lastStakeInfo != nil && *stakeInfo != *lastStakeInfo {
+		routes.wsHub.broadcast(Packet{
+			Event:   ticketStatusChanged,
+			Message: stakeInfo,
+		})
 	}
+
+	return stakeInfo
+}
+
+// sendWsChainReorg notifies clients of a chain reorganisation so that displayed transactions can be reloaded
+// with their new block heights and confirmations.
+func (routes *Routes) sendWsChainReorg(reorg *walletcore.ChainReorg) {
+	routes.wsHub.broadcast(Packet{
+		Event:   chainReorg,
+		Message: reorg,
+	})
+	routes.sendWsBalance()
 }
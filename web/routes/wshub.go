@@ -0,0 +1,244 @@
+package routes
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/raedahgroup/godcr/web/weblog"
+)
+
+const (
+	// time allowed to write a message to a client
+	wsWriteWait = 10 * time.Second
+
+	// time allowed to read the next pong or other message from a client
+	wsPongWait = 60 * time.Second
+
+	// interval for sending pings to clients, must be less than wsPongWait
+	wsPingPeriod = (wsPongWait * 9) / 10
+
+	// maximum size of messages sent by clients, which are only subscription requests and pings
+	wsMaxMessageSize = 1024
+
+	// number of packets that can be queued for a client before the client is considered too slow and disconnected
+	wsSendBufferSize = 32
+)
+
+// events sent by clients to manage the events they receive.
+// All clients receive every event until they send a subscribe request, after which they only receive subscribed events.
+const (
+	subscribeEvent   eventType = "subscribe"
+	unsubscribeEvent eventType = "unsubscribe"
+	clientPingEvent  eventType = "ping"
+)
+
+// wsHub keeps track of connected websocket clients and broadcasts packets to them.
+// It is safe for concurrent use.
+type wsHub struct {
+	mu      sync.RWMutex
+	clients map[*wsClient]struct{}
+	closed  bool
+}
+
+func newWsHub() *wsHub {
+	return &wsHub{
+		clients: map[*wsClient]struct{}{},
+	}
+}
+
+// wsClient is a websocket connection with its own goroutines for reading from and writing to the connection.
+// Packets to be written to the connection are queued on `send`, which is closed when the client is removed from the hub.
+type wsClient struct {
+	hub  *wsHub
+	conn *websocket.Conn
+	send chan Packet
+
+	subscriptionsMu sync.RWMutex
+	subscriptions   map[eventType]bool // nil means the client receives all events
+}
+
+// addClient registers a new connection with the hub and starts the client's read and write goroutines
+func (hub *wsHub) addClient(conn *websocket.Conn) {
+	client := &wsClient{
+		hub:  hub,
+		conn: conn,
+		send: make(chan Packet, wsSendBufferSize),
+	}
+
+	hub.mu.Lock()
+	if hub.closed {
+		hub.mu.Unlock()
+		conn.Close()
+		return
+	}
+	hub.clients[client] = struct{}{}
+	hub.mu.Unlock()
+
+	go client.writePump()
+	go client.readPump()
+}
+
+// removeClient unregisters the client and closes its send channel, which stops the client's write goroutine
+func (hub *wsHub) removeClient(client *wsClient) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if _, ok := hub.clients[client]; ok {
+		delete(hub.clients, client)
+		close(client.send)
+	}
+}
+
+// broadcast queues the packet for sending to all clients subscribed to the packet's event.
+// Clients whose send queue is full are disconnected rather than allowed to block other clients.
+func (hub *wsHub) broadcast(packet Packet) {
+	var slowClients []*wsClient
+
+	hub.mu.RLock()
+	for client := range hub.clients {
+		if !client.isSubscribed(packet.Event) {
+			continue
+		}
+
+		select {
+		case client.send <- packet:
+		default:
+			slowClients = append(slowClients, client)
+		}
+	}
+	hub.mu.RUnlock()
+
+	for _, client := range slowClients {
+		weblog.Log.Warnf("Disconnecting slow websocket client %s", client.conn.RemoteAddr())
+		hub.removeClient(client)
+	}
+}
+
+// close disconnects all clients and prevents new clients from being added
+func (hub *wsHub) close() {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	hub.closed = true
+	for client := range hub.clients {
+		delete(hub.clients, client)
+		close(client.send)
+	}
+}
+
+func (client *wsClient) isSubscribed(event eventType) bool {
+	client.subscriptionsMu.RLock()
+	defer client.subscriptionsMu.RUnlock()
+
+	return client.subscriptions == nil || client.subscriptions[event]
+}
+
+func (client *wsClient) updateSubscriptions(subscribe bool, events []eventType) {
+	client.subscriptionsMu.Lock()
+	defer client.subscriptionsMu.Unlock()
+
+	if client.subscriptions == nil {
+		if !subscribe {
+			// unsubscribing from some events, keep receiving every other event
+			client.subscriptions = map[eventType]bool{}
+			for _, event := range allEvents {
+				client.subscriptions[event] = true
+			}
+		} else {
+			client.subscriptions = map[eventType]bool{}
+		}
+	}
+
+	for _, event := range events {
+		if subscribe {
+			client.subscriptions[event] = true
+		} else {
+			delete(client.subscriptions, event)
+		}
+	}
+}
+
+// readPump reads subscription requests from the client until the connection is closed or the client stops responding to pings
+func (client *wsClient) readPump() {
+	defer func() {
+		client.hub.removeClient(client)
+		client.conn.Close()
+	}()
+
+	client.conn.SetReadLimit(wsMaxMessageSize)
+	client.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	client.conn.SetPongHandler(func(string) error {
+		return client.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	for {
+		_, message, err := client.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				weblog.LogError(fmt.Errorf("ws read error: %s", err.Error()))
+			}
+			return
+		}
+
+		// any message from the client shows that the connection is still alive
+		client.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+
+		var request struct {
+			Event   eventType       `json:"event"`
+			Message json.RawMessage `json:"message"`
+		}
+		if err = json.Unmarshal(message, &request); err != nil {
+			weblog.Log.Debugf("Invalid ws message from %s: %s", client.conn.RemoteAddr(), err.Error())
+			continue
+		}
+
+		switch request.Event {
+		case subscribeEvent, unsubscribeEvent:
+			var events []eventType
+			if err = json.Unmarshal(request.Message, &events); err != nil {
+				weblog.Log.Debugf("Invalid ws %s request from %s: %s", request.Event, client.conn.RemoteAddr(), err.Error())
+				continue
+			}
+			client.updateSubscriptions(request.Event == subscribeEvent, events)
+		case clientPingEvent:
+			// read deadline already extended
+		}
+	}
+}
+
+// writePump writes queued packets and periodic pings to the client until the send channel is closed or a write fails
+func (client *wsClient) writePump() {
+	pingTicker := time.NewTicker(wsPingPeriod)
+	defer func() {
+		pingTicker.Stop()
+		client.conn.Close()
+	}()
+
+	for {
+		select {
+		case packet, ok := <-client.send:
+			client.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if !ok {
+				// client removed from hub
+				client.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+
+			if err := client.conn.WriteJSON(packet); err != nil {
+				weblog.LogError(fmt.Errorf("ws update error: %s", err.Error()))
+				client.hub.removeClient(client)
+				return
+			}
+
+		case <-pingTicker.C:
+			client.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := client.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				client.hub.removeClient(client)
+				return
+			}
+		}
+	}
+}
@@ -2,12 +2,13 @@ package web
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"io/fs"
 	"net"
 	"net/http"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"runtime"
 	"strings"
 	"time"
@@ -20,25 +21,49 @@ import (
 	"github.com/raedahgroup/godcr/web/weblog"
 )
 
-func StartServer(ctx context.Context, walletMiddleware app.WalletMiddleware, httpHost, httpPort string, settings *config.Settings) error {
+func StartServer(ctx context.Context, walletMiddleware app.WalletMiddleware, appConfig *config.Config) error {
+	authConfig := routes.AuthConfig{
+		Username:       appConfig.HTTPUsername,
+		PasswordHash:   appConfig.HTTPPasswordHash,
+		APITokens:      appConfig.HTTPAPITokens,
+		SessionTimeout: appConfig.HTTPSessionTimeout,
+	}
+	if err := checkAuthConfig(appConfig.HTTPHost, authConfig); err != nil {
+		return err
+	}
+
+	if appConfig.HTTPTLS {
+		if err := ensureTLSCertificate(appConfig.HTTPCert, appConfig.HTTPKey, appConfig.HTTPHost); err != nil {
+			return err
+		}
+	}
+
 	router := chi.NewRouter()
+	router.Use(logRequests, securityHeaders, limitRequestBody(maxRequestBodySize))
+
+	assets, err := loadAssets(appConfig.HTTPAssetsDir)
+	if err != nil {
+		return err
+	}
 
 	// setup static file serving
-	workDir, _ := os.Getwd()
-	filesDir := filepath.Join(workDir, "web/static/dist")
-	makeStaticFileServer(router, "/static", http.Dir(filesDir))
+	staticFiles, err := fs.Sub(assets, "static/dist")
+	if err != nil {
+		return err
+	}
+	makeStaticFileServer(router, "/static", http.FS(staticFiles))
 
 	// setup routes for templated pages, returns sync blockchain function if wallet is successfully opened
 	// returns error if wallet exists but could not be opened
-	syncBlockchain, err := routes.OpenWalletAndSetupRoutes(ctx, walletMiddleware, router, settings)
+	syncBlockchain, err := routes.OpenWalletAndSetupRoutes(ctx, walletMiddleware, router, assets, authConfig, &appConfig.Settings)
 	if err != nil {
 		return err
 	}
 
 	fmt.Println("Starting web server")
 
-	serverAddress := net.JoinHostPort(httpHost, httpPort)
-	err = startServer(ctx, serverAddress, router)
+	serverAddress := net.JoinHostPort(appConfig.HTTPHost, appConfig.HTTPPort)
+	err = startServer(ctx, serverAddress, router, appConfig)
 	if err != nil {
 		return err
 	}
@@ -58,6 +83,27 @@ func StartServer(ctx context.Context, walletMiddleware app.WalletMiddleware, htt
 	return nil
 }
 
+// checkAuthConfig prevents the web server from being exposed to other computers without authentication.
+// Authentication is optional if the server only listens on the loopback interface.
+func checkAuthConfig(httpHost string, authConfig routes.AuthConfig) error {
+	if authConfig.Enabled() {
+		return nil
+	}
+
+	isLoopback := httpHost == "localhost"
+	if ip := net.ParseIP(httpHost); ip != nil && ip.IsLoopback() {
+		isLoopback = true
+	}
+
+	if isLoopback {
+		weblog.LogWarn("Web authentication is not configured. Run godcr httpauth to require a login for the web interface")
+		return nil
+	}
+
+	return fmt.Errorf("web authentication must be configured to serve the web interface on %s. "+
+		"Run godcr httpauth to set a username and password", httpHost)
+}
+
 func makeStaticFileServer(router chi.Router, path string, root http.FileSystem) {
 	if strings.ContainsAny(path, "{}*") {
 		panic("FileServer does not permit URL parameters.")
@@ -81,16 +127,34 @@ func makeStaticFileServer(router chi.Router, path string, root http.FileSystem)
 // startServer waits 2 seconds to catch error sent to `errChan` and returns the error
 // startServer returns nil, if no error was received during the 2-seconds window
 // startServer returns error if ctx is canceled while waiting
-func startServer(ctx context.Context, address string, router chi.Router) error {
+// the server listens for https connections if tls is enabled in config
+func startServer(ctx context.Context, address string, router chi.Router, appConfig *config.Config) error {
 	// check if context has been canceled before attempting to start server
 	err := ctx.Err()
 	if err != nil {
 		return err
 	}
 
+	server := &http.Server{
+		Addr:    address,
+		Handler: router,
+	}
+
+	scheme := "http"
+	if appConfig.HTTPTLS {
+		scheme = "https"
+		server.TLSConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
 	errChan := make(chan error)
 	go func() {
-		errChan <- http.ListenAndServe(address, router)
+		if appConfig.HTTPTLS {
+			errChan <- server.ListenAndServeTLS(appConfig.HTTPCert, appConfig.HTTPKey)
+		} else {
+			errChan <- server.ListenAndServe()
+		}
 	}()
 
 	// briefly wait for an error and then return
@@ -103,13 +167,14 @@ func startServer(ctx context.Context, address string, router chi.Router) error {
 		fmt.Fprintln(os.Stderr, "Web server not started")
 		return ctx.Err()
 	case <-t.C:
-		fmt.Printf("Web server running on %s\n", address)
-		go askToLaunchBrowser(address) // run in goroutine so this function returns immediately without waiting for user response
+		serverURL := fmt.Sprintf("%s://%s", scheme, address)
+		fmt.Printf("Web server running on %s\n", serverURL)
+		go askToLaunchBrowser(serverURL) // run in goroutine so this function returns immediately without waiting for user response
 		return nil
 	}
 }
 
-func askToLaunchBrowser(address string) {
+func askToLaunchBrowser(serverURL string) {
 	launchBrowserConfirmed, err := terminalprompt.RequestYesNoConfirmation("Do you want to launch the web browser?", "")
 	if err != nil {
 		weblog.Log.Error("Failed to read input", err.Error())
@@ -123,7 +188,7 @@ func askToLaunchBrowser(address string) {
 
 	fmt.Print("Launching browser... ") // use print so next text can be added to same line
 
-	if launchError := launchBrowser("http://" + address); launchError != nil {
+	if launchError := launchBrowser(serverURL); launchError != nil {
 		weblog.Log.Error("Failed to launch browser", launchError.Error())
 		fmt.Println("Browser failed to launch.")
 	} else {
@@ -4,7 +4,57 @@ import { showErrorNotification, showSuccessNotification } from '../utils'
 
 export default class extends Controller {
   static get targets () {
-    return ['hideAccount', 'defaultAccount']
+    return ['hideAccount', 'defaultAccount', 'accountName', 'xpub']
+  }
+
+  renameAccount (e) {
+    e.preventDefault()
+    const form = e.currentTarget
+    const accountNumber = form.getAttribute('data-account')
+    const newNameInput = form.querySelector('input[name="new-name"]')
+
+    if (newNameInput.value === '') {
+      showErrorNotification('The new account name is required')
+      return
+    }
+
+    axios.post(`/rename-account/${accountNumber}`, $(form).serialize()).then((response) => {
+      const result = response.data
+      if (result.success) {
+        this.accountNameTargets.forEach(el => {
+          if (el.getAttribute('data-account') === accountNumber) {
+            el.textContent = result.name
+          }
+        })
+        newNameInput.value = ''
+        showSuccessNotification('Account renamed successfully')
+      } else {
+        showErrorNotification(result.error ? result.error : 'Something went wrong, please try again later')
+      }
+    }).catch(() => {
+      showErrorNotification('A server error occurred')
+    })
+  }
+
+  showExtendedPubKey (e) {
+    const button = e.currentTarget
+    const accountNumber = button.getAttribute('data-account')
+
+    axios.get(`/account-xpub/${accountNumber}`).then((response) => {
+      const result = response.data
+      if (result.success) {
+        this.xpubTargets.forEach(el => {
+          if (el.getAttribute('data-account') === accountNumber) {
+            el.textContent = result.xpub
+          }
+        })
+        button.classList.add('d-none')
+      } else {
+        showErrorNotification(result.error ? result.error : 'Something went wrong, please try again later')
+      }
+    }).catch(() => {
+      showErrorNotification('A server error occurred')
+    })
   }
 
   toggleHideAccount (e) {
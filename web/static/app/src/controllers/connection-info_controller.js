@@ -1,5 +1,5 @@
 import { Controller } from 'stimulus'
-import { hide, show } from '../utils'
+import { hide, show, showSuccessNotification, showWarningNotification } from '../utils'
 import ws from '../services/messagesocket_service'
 
 export default class extends Controller {
@@ -9,6 +9,7 @@ export default class extends Controller {
       'peersConnected',
       'latestBlock',
       'networkType',
+      'walletRPCState', 'walletRPCStateContainer',
       'blockScanProgress'
     ]
   }
@@ -16,15 +17,39 @@ export default class extends Controller {
   connect () {
     ws.registerEvtHandler('updateConnInfo', data => {
       this.peersConnectedTarget.textContent = data.peersConnected
+      this.peersConnectedTarget.title = data.peerAddresses ? data.peerAddresses.join(', ') : ''
       this.totalBalanceTarget.textContent = data.totalBalance
       this.latestBlockTarget.textContent = data.latestBlock
       this.networkTypeTarget.textContent = data.networkType
+      if (data.walletRPCState) {
+        this.walletRPCStateTarget.textContent = data.walletRPCState
+        show(this.walletRPCStateContainerTarget)
+      } else {
+        hide(this.walletRPCStateContainerTarget)
+      }
     })
 
     ws.registerEvtHandler('updateBalance', data => {
       this.totalBalanceTarget.textContent = data.total
     })
 
+    ws.registerEvtHandler('newTransaction', tx => {
+      if (tx.notify) {
+        showSuccessNotification(`New transaction: ${tx.direction} ${tx.amount}`)
+      }
+    })
+
+    ws.registerEvtHandler('ticketStatusChanged', stakeInfo => {
+      showSuccessNotification(`Tickets updated: ${stakeInfo.live} live, ${stakeInfo.immature} immature, ${stakeInfo.voted} voted`)
+    })
+
+    ws.registerEvtHandler('chainReorg', reorg => {
+      const affectedTxCount = reorg.affectedTxHashes ? reorg.affectedTxHashes.length : 0
+      if (affectedTxCount > 0) {
+        showWarningNotification(`Chain reorganisation at block ${reorg.forkHeight} changed ${affectedTxCount} transaction(s). Reload to see their updated confirmations`)
+      }
+    })
+
     ws.registerEvtHandler('updateSyncProgress', syncInfo => {
       // hide the persistent blocks rescan progress section if this is the initial sync on server start (i.e. !syncInfo.done)
       // or if block headers rescan has not started or has completed (i.e. syncInfo.rescanProgress <= 0 || syncInfo.rescanProgress >= 100)
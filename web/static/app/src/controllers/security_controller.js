@@ -0,0 +1,122 @@
+import { Controller } from 'stimulus'
+import axios from 'axios'
+import { hide, show } from '../utils'
+
+export default class extends Controller {
+  static get targets () {
+    return [
+      'importValue', 'rescan', 'rescanHeightGroup', 'errorMessage', 'successMessage', 'submitButton',
+      'sweepPrivateKey', 'sweepErrorMessage', 'sweepSuccessMessage', 'sweepButton',
+      // from wallet passphrase modal (utils.html)
+      'walletPassphrase', 'passwordError'
+    ]
+  }
+
+  toggleRescanHeight () {
+    if (this.rescanTarget.checked) {
+      show(this.rescanHeightGroupTarget)
+    } else {
+      hide(this.rescanHeightGroupTarget)
+    }
+  }
+
+  getWalletPassphraseAndSubmit () {
+    this.clearMessages()
+    if (this.importValueTarget.value === '') {
+      this.setErrorMessage('The private key or script to import is required')
+      return
+    }
+    $('#passphrase-modal').modal()
+  }
+
+  submitForm () {
+    if (this.walletPassphraseTarget.value === '') {
+      this.passwordErrorTarget.innerHTML = '<div class="error">Your wallet passphrase is required</div>'
+      return
+    }
+
+    $('#passphrase-modal').modal('hide')
+
+    this.submitButtonTarget.innerHTML = 'Importing...'
+    this.submitButtonTarget.setAttribute('disabled', 'disabled')
+
+    const postData = $('#import-form').serialize()
+
+    // clear password input
+    this.walletPassphraseTarget.value = ''
+
+    let _this = this
+    axios.post('/import', postData).then((response) => {
+      let result = response.data
+      if (result.error) {
+        _this.setErrorMessage(result.error)
+      } else {
+        _this.importValueTarget.value = ''
+        let successMsg = 'Import successful.'
+        if (_this.rescanTarget.checked) {
+          successMsg += ' Transactions for the import will be shown after the rescan completes.'
+        }
+        _this.setSuccessMessage(successMsg)
+      }
+    }).catch(() => {
+      _this.setErrorMessage('A server error occurred')
+    }).then(() => {
+      _this.submitButtonTarget.innerHTML = 'Import'
+      _this.submitButtonTarget.removeAttribute('disabled')
+    })
+  }
+
+  sweep () {
+    hide(this.sweepErrorMessageTarget)
+    hide(this.sweepSuccessMessageTarget)
+
+    if (this.sweepPrivateKeyTarget.value === '') {
+      show(this.sweepErrorMessageTarget)
+      this.sweepErrorMessageTarget.innerHTML = 'The private key to sweep is required'
+      return
+    }
+
+    this.sweepButtonTarget.innerHTML = 'Sweeping...'
+    this.sweepButtonTarget.setAttribute('disabled', 'disabled')
+
+    const postData = $('#sweep-form').serialize()
+    this.sweepPrivateKeyTarget.value = ''
+
+    let _this = this
+    axios.post('/sweep', postData).then((response) => {
+      let result = response.data
+      if (result.error) {
+        show(_this.sweepErrorMessageTarget)
+        _this.sweepErrorMessageTarget.innerHTML = result.error
+      } else {
+        show(_this.sweepSuccessMessageTarget)
+        _this.sweepSuccessMessageTarget.innerHTML = `Swept ${result.amount} from ${result.sourceAddress}<br/>` +
+          `<a href="/transaction-details/${result.txHash}">${result.txHash}</a>`
+      }
+    }).catch(() => {
+      show(_this.sweepErrorMessageTarget)
+      _this.sweepErrorMessageTarget.innerHTML = 'A server error occurred'
+    }).then(() => {
+      _this.sweepButtonTarget.innerHTML = 'Sweep'
+      _this.sweepButtonTarget.removeAttribute('disabled')
+    })
+  }
+
+  setErrorMessage (message) {
+    hide(this.successMessageTarget)
+    show(this.errorMessageTarget)
+    this.errorMessageTarget.innerHTML = message
+  }
+
+  setSuccessMessage (message) {
+    hide(this.errorMessageTarget)
+    show(this.successMessageTarget)
+    this.successMessageTarget.innerHTML = message
+  }
+
+  clearMessages () {
+    hide(this.errorMessageTarget)
+    hide(this.successMessageTarget)
+    this.passwordErrorTarget.innerHTML = ''
+  }
+}
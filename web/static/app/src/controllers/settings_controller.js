@@ -1,6 +1,7 @@
 import { Controller } from 'stimulus'
 import axios from 'axios'
 import { hide, show, showErrorNotification, showSuccessNotification } from '../utils'
+import ws from '../services/messagesocket_service'
 
 export default class extends Controller {
   static get targets () {
@@ -9,10 +10,31 @@ export default class extends Controller {
       'confirmPasswordError', 'changePasswordErrorMessage',
       'spendUnconfirmedFunds', 'showIncomingTransactionNotification', 'showNewBlockNotification',
       'changeCurrencyConverterErrorMessage', 'currencyConverterNone', 'currencyConverterBitrex', 'updateCurrencyConverterButton',
-      'rescanBlockChainButton'
+      'rescanBlockChainButton', 'rescanFromHeight', 'rescanProgress',
+      'spvConnect', 'spvConnectErrorMessage', 'spvConnectSummary'
     ]
   }
 
+  connect () {
+    ws.registerEvtHandler('updateSyncProgress', syncInfo => {
+      if (this.rescanBlockChainButtonTarget.textContent !== 'Rescan Blockchain (In Progress)' || !syncInfo.done) {
+        return
+      }
+
+      if (syncInfo.rescanProgress >= 100) {
+        this.rescanBlockChainButtonTarget.textContent = 'Rescan Blockchain'
+        hide(this.rescanProgressTarget)
+        showSuccessNotification('Block headers rescan completed')
+        return
+      }
+
+      if (syncInfo.rescanProgress > 0) {
+        this.rescanProgressTarget.textContent = `${syncInfo.rescanProgress}%, scanning ${syncInfo.currentRescanHeight} of ${syncInfo.totalHeadersToFetch} block headers`
+        show(this.rescanProgressTarget)
+      }
+    })
+  }
+
   changePassword (e) {
     e.preventDefault()
     if (!this.validateChangePasswordFields()) {
@@ -156,6 +178,43 @@ export default class extends Controller {
     })
   }
 
+  updateLogLevel (e) {
+    const subsystem = e.currentTarget.getAttribute('data-subsystem')
+    const level = e.currentTarget.value
+    const postData = `log-level=${encodeURIComponent(`${subsystem}=${level}`)}`
+    axios.put('/settings', postData).then((response) => {
+      let result = response.data
+      if (result.error) {
+        showErrorNotification(result.error)
+        return
+      }
+      showSuccessNotification(`${subsystem} log level set to ${level}`)
+    }).catch(() => {
+      showErrorNotification('A server error occurred')
+    })
+  }
+
+  updateSPVConnect () {
+    const _this = this
+    const spvConnect = this.spvConnectTarget.value.trim()
+    const postData = `spv-connect=${encodeURIComponent(spvConnect)}`
+    axios.put('/settings', postData).then((response) => {
+      let result = response.data
+      if (result.error) {
+        _this.spvConnectErrorMessageTarget.textContent = result.error
+        show(_this.spvConnectErrorMessageTarget)
+        return
+      }
+      hide(_this.spvConnectErrorMessageTarget)
+      _this.spvConnectSummaryTarget.textContent = spvConnect ? 'Only connecting to specified peers' : 'Peers are discovered automatically'
+      showSuccessNotification('Changes saved. Restart godcr to sync with the new peers')
+      $('#spv-connect-modal').modal('hide')
+    }).catch(() => {
+      _this.spvConnectErrorMessageTarget.textContent = 'A server error occurred'
+      show(_this.spvConnectErrorMessageTarget)
+    })
+  }
+
   rescanBlockchain () {
     if (this.rescanBlockChainButtonTarget.textContent !== 'Rescan Blockchain') {
       return
@@ -163,7 +222,8 @@ export default class extends Controller {
     this.rescanBlockChainButtonTarget.textContent = 'Starting...'
 
     const _this = this
-    axios.post('/rescan-blockchain').then((response) => {
+    const postData = `from-height=${encodeURIComponent(this.rescanFromHeightTarget.value.trim())}`
+    axios.post('/rescan-blockchain', postData).then((response) => {
       let result = response.data
       if (result.error) {
         showErrorNotification(`Block headers rescan failed. ${result.error}`)
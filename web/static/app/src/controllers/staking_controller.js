@@ -1,12 +1,13 @@
 import { Controller } from 'stimulus'
 import axios from 'axios'
-import { hide, show, listenForBalanceUpdate } from '../utils'
+import { hide, show, listenForBalanceUpdate, showErrorNotification, showSuccessNotification } from '../utils'
 
 export default class extends Controller {
   static get targets () {
     return [
       'errorMessage', 'successMessage',
       'sourceAccount', 'numberOfTickets', 'spendUnconfirmed', 'errors', 'submitButton',
+      'vspApiUrl', 'vspApiKey', 'vspWalletPassphrase', 'vspErrorMessage', 'vspSubmitButton',
       // from wallet passphrase modal (utils.html)
       'walletPassphrase', 'passwordError'
     ]
@@ -89,6 +90,64 @@ export default class extends Controller {
     $('#passphrase-modal').modal()
   }
 
+  setVoteChoice (event) {
+    const agendaId = event.target.dataset.agendaId
+    const choiceId = event.target.value
+    const postData = `agenda-id=${encodeURIComponent(agendaId)}&choice-id=${encodeURIComponent(choiceId)}`
+
+    axios.post('/vote-choice', postData).then((response) => {
+      let result = response.data
+      if (result.error) {
+        showErrorNotification(result.error)
+      } else {
+        showSuccessNotification(`Vote choice for ${agendaId} set to ${choiceId}`)
+      }
+    }).catch(() => {
+      showErrorNotification('A server error occurred')
+    })
+  }
+
+  setupVSP () {
+    hide(this.vspErrorMessageTarget)
+    if (this.vspApiUrlTarget.value === '' || this.vspApiKeyTarget.value === '') {
+      this.setVSPErrorMessage('The API URL and API key of the voting service provider are required')
+      return
+    }
+    if (this.vspWalletPassphraseTarget.value === '') {
+      this.setVSPErrorMessage('The wallet passphrase is required to import the voting service provider\'s ticket script')
+      return
+    }
+
+    this.vspSubmitButtonTarget.setAttribute('disabled', 'disabled')
+    this.updateVSPConfig($('#vsp-config-form').serialize())
+  }
+
+  removeVSP () {
+    hide(this.vspErrorMessageTarget)
+    this.updateVSPConfig('remove=1')
+  }
+
+  updateVSPConfig (postData) {
+    let _this = this
+    axios.post('/vsp-config', postData).then((response) => {
+      let result = response.data
+      if (result.error) {
+        _this.setVSPErrorMessage(result.error)
+      } else {
+        window.location.reload()
+      }
+    }).catch(() => {
+      _this.setVSPErrorMessage('A server error occurred')
+    }).then(() => {
+      _this.vspSubmitButtonTarget.removeAttribute('disabled')
+    })
+  }
+
+  setVSPErrorMessage (message) {
+    show(this.vspErrorMessageTarget)
+    this.vspErrorMessageTarget.innerHTML = message
+  }
+
   setErrorMessage (message) {
     hide(this.successMessageTarget)
     show(this.errorMessageTarget)
@@ -1,4 +1,5 @@
 import { Controller } from 'stimulus'
+import axios from 'axios'
 import { hide, show } from '../utils'
 import ws from '../services/messagesocket_service'
 
@@ -10,7 +11,8 @@ export default class extends Controller {
       'step1', 'fetchedHeadersCount', 'totalHeadersToFetch', 'headersFetchProgress', 'daysBehind',
       'step2', 'addressDiscoveryProgress',
       'step3', 'currentRescanHeight', 'rescanProgress',
-      'connectedPeers', 'networkType'
+      'connectedPeers', 'networkType',
+      'syncError', 'cancelButton', 'restartButton'
     ]
   }
 
@@ -71,6 +73,7 @@ export default class extends Controller {
 
       this.connectedPeersTarget.textContent = syncInfo.connectedPeers
       this.networkTypeTarget.textContent = syncInfo.networkType
+      this.showSyncStatus(syncInfo.syncFailed, syncInfo.syncError)
 
       if (syncInfo.done) {
         window.location.reload(true)
@@ -78,6 +81,44 @@ export default class extends Controller {
     })
   }
 
+  showSyncStatus (syncFailed, syncError) {
+    if (syncFailed) {
+      this.syncErrorTarget.textContent = `Sync error: ${syncError}`
+      show(this.syncErrorTarget)
+      hide(this.cancelButtonTarget)
+      show(this.restartButtonTarget)
+    } else {
+      hide(this.syncErrorTarget)
+      show(this.cancelButtonTarget)
+      hide(this.restartButtonTarget)
+    }
+  }
+
+  cancelSync () {
+    this.cancelButtonTarget.setAttribute('disabled', 'disabled')
+    axios.post('/cancel-sync').catch(() => {
+      this.showSyncStatus(true, 'A server error occurred')
+    }).then(() => {
+      this.cancelButtonTarget.removeAttribute('disabled')
+    })
+  }
+
+  restartSync () {
+    this.restartButtonTarget.setAttribute('disabled', 'disabled')
+    axios.post('/restart-sync').then((response) => {
+      const result = response.data
+      if (result.success) {
+        this.showSyncStatus(false)
+      } else {
+        this.showSyncStatus(true, result.error ? result.error : 'Something went wrong, please try again later')
+      }
+    }).catch(() => {
+      this.showSyncStatus(true, 'A server error occurred')
+    }).then(() => {
+      this.restartButtonTarget.removeAttribute('disabled')
+    })
+  }
+
   showDetails () {
     hide(this.showDetailsButtonTarget)
     show(this.syncDetailsTarget)
@@ -0,0 +1,55 @@
+import { Controller } from 'stimulus'
+import axios from 'axios'
+import { showErrorNotification, showSuccessNotification } from '../utils'
+
+export default class extends Controller {
+  static get targets () {
+    return ['bumpFeeButton', 'abandonButton']
+  }
+
+  bumpFee (e) {
+    e.preventDefault()
+    const form = e.currentTarget
+
+    if (form.querySelector('input[name="passphrase"]').value === '') {
+      showErrorNotification('The spending passphrase is required')
+      return
+    }
+
+    this.bumpFeeButtonTarget.setAttribute('disabled', 'disabled')
+    axios.post(`/transaction-details/${this.data.get('hash')}/bump-fee`, $(form).serialize()).then((response) => {
+      const result = response.data
+      if (result.success) {
+        showSuccessNotification('Transaction published')
+        window.location.href = `/transaction-details/${result.txHash}`
+      } else {
+        showErrorNotification(result.error ? result.error : 'Something went wrong, please try again later')
+      }
+    }).catch(() => {
+      showErrorNotification('A server error occurred')
+    }).then(() => {
+      this.bumpFeeButtonTarget.removeAttribute('disabled')
+    })
+  }
+
+  abandon () {
+    if (!window.confirm('Abandon this transaction? Its inputs will be available to spend again, but the transaction may still be mined if other nodes have it.')) {
+      return
+    }
+
+    this.abandonButtonTarget.setAttribute('disabled', 'disabled')
+    axios.post(`/transaction-details/${this.data.get('hash')}/abandon`).then((response) => {
+      const result = response.data
+      if (result.success) {
+        showSuccessNotification('Transaction abandoned')
+        window.location.href = '/history'
+      } else {
+        showErrorNotification(result.error ? result.error : 'Something went wrong, please try again later')
+        this.abandonButtonTarget.removeAttribute('disabled')
+      }
+    }).catch(() => {
+      showErrorNotification('A server error occurred')
+      this.abandonButtonTarget.removeAttribute('disabled')
+    })
+  }
+}
@@ -0,0 +1,52 @@
+import { Controller } from 'stimulus'
+import axios from 'axios'
+import { showErrorNotification, showSuccessNotification } from '../utils'
+
+export default class extends Controller {
+  static get targets () {
+    return ['rebroadcastButton', 'transaction']
+  }
+
+  rebroadcast () {
+    this.rebroadcastButtonTarget.setAttribute('disabled', 'disabled')
+    axios.post('/rebroadcast-unmined').then((response) => {
+      const result = response.data
+      if (result.success) {
+        showSuccessNotification('Unmined transactions rebroadcast')
+      } else {
+        showErrorNotification(result.error ? result.error : 'Something went wrong, please try again later')
+      }
+    }).catch(() => {
+      showErrorNotification('A server error occurred')
+    }).then(() => {
+      this.rebroadcastButtonTarget.removeAttribute('disabled')
+    })
+  }
+
+  abandon (e) {
+    const button = e.currentTarget
+    const hash = button.getAttribute('data-hash')
+    if (!window.confirm('Abandon this transaction? Its inputs will be available to spend again, but the transaction may still be mined if other nodes have it.')) {
+      return
+    }
+
+    button.setAttribute('disabled', 'disabled')
+    axios.post(`/transaction-details/${hash}/abandon`).then((response) => {
+      const result = response.data
+      if (result.success) {
+        this.transactionTargets.forEach(el => {
+          if (el.getAttribute('data-hash') === hash) {
+            el.remove()
+          }
+        })
+        showSuccessNotification('Transaction abandoned')
+      } else {
+        showErrorNotification(result.error ? result.error : 'Something went wrong, please try again later')
+        button.removeAttribute('disabled')
+      }
+    }).catch(() => {
+      showErrorNotification('A server error occurred')
+      button.removeAttribute('disabled')
+    })
+  }
+}
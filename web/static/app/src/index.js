@@ -1,5 +1,6 @@
 import { Application } from 'stimulus'
 import { definitionsFromContext } from 'stimulus/webpack-helpers'
+import axios from 'axios'
 import '../node_modules/toastr/build/toastr.css'
 import '../node_modules/bootstrap4-toggle/css/bootstrap4-toggle.css'
 import '../node_modules/bootstrap4-toggle/js/bootstrap4-toggle.js'
@@ -11,6 +12,11 @@ import { faCopy } from '@fortawesome/free-solid-svg-icons'
 library.add(faCopy)
 dom.watch()
 
+// axios sends the csrf token set by the server in the XSRF-TOKEN cookie as an X-XSRF-TOKEN header
+// with every request, the server rejects state-changing requests without it
+axios.defaults.xsrfCookieName = 'XSRF-TOKEN'
+axios.defaults.xsrfHeaderName = 'X-XSRF-TOKEN'
+
 function getSocketURI () {
   let protocol = (window.location.protocol === 'https:') ? 'wss' : 'ws'
   return `${protocol}://${window.location.host}/ws`
@@ -10,6 +10,8 @@
 // register(id, handler_function) -- register a function to handle events of
 //     the given type
 // send(id, data) -- create a JSON message in the above format and send it
+// subscribe(events) -- only receive the given event types (all events are received until subscribe is called)
+// unsubscribe(events) -- stop receiving the given event types
 //
 // Copyright (c) 2017, Jonathan Chappelow
 // See LICENSE for details.
@@ -57,6 +59,14 @@ class MessageSocket {
     this.connection.send(payload)
   }
 
+  subscribe (eventIDs) {
+    this.send('subscribe', eventIDs)
+  }
+
+  unsubscribe (eventIDs) {
+    this.send('unsubscribe', eventIDs)
+  }
+
   connect (uri) {
     this.uri = uri
     this.connection = new window.WebSocket(uri)
@@ -75,6 +75,10 @@ export const showSuccessNotification = (message) => {
   toastr.success(message)
 }
 
+export const showWarningNotification = (message) => {
+  toastr.warning(message)
+}
+
 export const hide = (el) => {
   el.classList.add('d-none')
 }
@@ -0,0 +1,78 @@
+package web
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil"
+)
+
+// selfSignedCertValidity is how long generated self-signed certificates remain valid
+const selfSignedCertValidity = 10 * 365 * 24 * time.Hour
+
+// ensureTLSCertificate checks that the certificate and key files exist and can be loaded.
+// If neither file exists, a self-signed certificate pair is generated, as dcrwallet does for its rpc.cert.
+// The certificate is valid for `httpHost`, localhost and all addresses of this computer's network interfaces.
+func ensureTLSCertificate(certFile, keyFile, httpHost string) error {
+	certExists := fileExists(certFile)
+	keyExists := fileExists(keyFile)
+
+	if certExists != keyExists {
+		if certExists {
+			return fmt.Errorf("tls certificate %s exists but key file %s was not found", certFile, keyFile)
+		}
+		return fmt.Errorf("tls key %s exists but certificate file %s was not found", keyFile, certFile)
+	}
+
+	if !certExists {
+		if err := generateSelfSignedCertificate(certFile, keyFile, httpHost); err != nil {
+			return fmt.Errorf("error generating tls certificate: %s", err.Error())
+		}
+		fmt.Printf("Generated self-signed tls certificate %s\n", certFile)
+	}
+
+	if _, err := tls.LoadX509KeyPair(certFile, keyFile); err != nil {
+		return fmt.Errorf("error loading tls certificate: %s", err.Error())
+	}
+	return nil
+}
+
+func generateSelfSignedCertificate(certFile, keyFile, httpHost string) error {
+	var extraHosts []string
+	if httpHost != "" {
+		if ip := net.ParseIP(httpHost); ip == nil || !ip.IsUnspecified() {
+			extraHosts = append(extraHosts, httpHost)
+		}
+	}
+
+	validUntil := time.Now().Add(selfSignedCertValidity)
+	cert, key, err := dcrutil.NewTLSCertPair("godcr autogenerated cert", validUntil, extraHosts)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range []string{certFile, keyFile} {
+		if err = os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+			return err
+		}
+	}
+
+	if err = ioutil.WriteFile(certFile, cert, 0644); err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		os.Remove(certFile)
+		return err
+	}
+	return nil
+}
+
+func fileExists(filePath string) bool {
+	_, err := os.Stat(filePath)
+	return err == nil
+}